@@ -0,0 +1,50 @@
+package commons
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// AwaitStateFunc reports the current state of the awaited object and whether
+// the wait is over. A non-nil error stops the wait and is returned as is.
+type AwaitStateFunc func() (state string, done bool, err error)
+
+// AwaitState polls refresh until it reports done or fails. It gives up after
+// timeout seconds, or when ctx is done, and reports the last state seen. A
+// zero timeout skips the wait.
+func AwaitState(ctx context.Context, description string, timeout int, refresh AwaitStateFunc) error {
+	if timeout == 0 {
+		return nil
+	}
+
+	var failure error
+	lastState := ""
+	err := resource.RetryContext(ctx, time.Second*time.Duration(timeout), func() *resource.RetryError {
+		state, done, err := refresh()
+		if err != nil {
+			failure = err
+			return resource.NonRetryableError(err)
+		}
+
+		lastState = state
+		if done {
+			log.Printf("===> %s reached state %q <===", description, state)
+			return nil
+		}
+
+		log.Printf("===> waiting for %s, current state: %q <===", description, state)
+		return resource.RetryableError(fmt.Errorf("%s is in state %q", description, state))
+	})
+
+	switch {
+	case failure != nil:
+		return failure
+	case err != nil:
+		return fmt.Errorf("timed out after %ds waiting for %s, last state: %q", timeout, description, lastState)
+	}
+	return nil
+}
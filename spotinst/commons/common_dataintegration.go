@@ -10,7 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-var vendorTypes = []string{"s3"}
+var vendorTypes = []string{"s3", "gcs"}
 
 const (
 	DataIntegrationResourceName ResourceName = "spotinst_data_integration"
@@ -97,7 +97,9 @@ func (res *DataIntegrationResourceTerraformResource) OnUpdate(
 	var vendor = ""
 	for _, field := range res.fields.fieldsMap {
 		if contains(vendorTypes, field.fieldNameStr) {
-			vendor = field.fieldNameStr
+			if _, ok := resourceData.GetOk(field.fieldNameStr); ok {
+				vendor = field.fieldNameStr
+			}
 		}
 		if field.onUpdate == nil {
 			continue
@@ -9,7 +9,8 @@ import (
 )
 
 const (
-	ElastigroupAWSResourceName ResourceName = "spotinst_elastigroup_aws"
+	ElastigroupAWSResourceName            ResourceName = "spotinst_elastigroup_aws"
+	ElastigroupAWSInstancesDataSourceName ResourceName = "spotinst_elastigroup_aws_instances"
 )
 
 var ElastigroupResource *ElastigroupTerraformResource
@@ -0,0 +1,130 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+)
+
+const (
+	ElastigroupAWSImportResourceName ResourceName = "spotinst_elastigroup_aws_import"
+)
+
+var ElastigroupAWSImportResource *ElastigroupAWSImportTerraformResource
+
+type ElastigroupAWSImportTerraformResource struct {
+	GenericResource
+}
+
+func NewElastigroupAWSImportResource(fieldsMap map[FieldName]*GenericField) *ElastigroupAWSImportTerraformResource {
+	return &ElastigroupAWSImportTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ElastigroupAWSImportResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *ElastigroupAWSImportTerraformResource) OnCreate(
+	importedGroup *aws.Group,
+	resourceData *schema.ResourceData,
+	meta interface{}) (*aws.Group, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	egWrapper := NewElastigroupWrapper()
+
+	if importedGroup != nil {
+		// This is the merge part of the import action
+		// onCreate on every field is the override action on top of what returned from Spotinst API
+		buildEmptyElastigroupImportRequirements(importedGroup)
+		egWrapper.SetElastigroup(importedGroup)
+	}
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(egWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return egWrapper.GetElastigroup(), nil
+}
+
+func (res *ElastigroupAWSImportTerraformResource) OnRead(
+	elastigroup *aws.Group,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	egWrapper := NewElastigroupWrapper()
+	egWrapper.SetElastigroup(elastigroup)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(egWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (res *ElastigroupAWSImportTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, *aws.Group, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	egWrapper := NewElastigroupWrapper()
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+			if err := field.onUpdate(egWrapper, resourceData, meta); err != nil {
+				return false, nil, err
+			}
+			hasChanged = true
+		}
+	}
+
+	return hasChanged, egWrapper.GetElastigroup(), nil
+}
+
+func buildEmptyElastigroupImportRequirements(elastigroup *aws.Group) {
+	if elastigroup == nil {
+		return
+	}
+
+	if elastigroup.Capacity == nil {
+		elastigroup.SetCapacity(&aws.Capacity{})
+	}
+
+	if elastigroup.Strategy == nil {
+		elastigroup.SetStrategy(&aws.Strategy{})
+	}
+
+	if elastigroup.Compute == nil {
+		elastigroup.SetCompute(&aws.Compute{})
+	}
+
+	if elastigroup.Compute.InstanceTypes == nil {
+		elastigroup.Compute.SetInstanceTypes(&aws.InstanceTypes{})
+	}
+}
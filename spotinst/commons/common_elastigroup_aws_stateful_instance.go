@@ -0,0 +1,91 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+)
+
+const (
+	ElastigroupAWSStatefulInstanceResourceName    ResourceName = "spotinst_elastigroup_aws_stateful_instance"
+	ElastigroupAWSStatefulInstancesDataSourceName ResourceName = "spotinst_elastigroup_aws_stateful_instances"
+)
+
+var ElastigroupAWSStatefulInstanceResource *ElastigroupAWSStatefulInstanceTerraformResource
+
+type ElastigroupAWSStatefulInstanceTerraformResource struct {
+	GenericResource
+}
+
+// ElastigroupAWSStatefulInstance is a stateful instance of an Elastigroup.
+// The API has no way to create or delete a stateful instance on its own, so
+// the resource only manages the state of an existing one.
+type ElastigroupAWSStatefulInstance struct {
+	GroupID            *string
+	StatefulInstanceID *string
+
+	StatefulInstance *aws.StatefulInstance
+}
+
+// NewElastigroupAWSStatefulInstance wraps a stateful instance read from the
+// API, or nil if the group has no such instance, for OnRead.
+func NewElastigroupAWSStatefulInstance(groupID, statefulInstanceID string, statefulInstance *aws.StatefulInstance) *ElastigroupAWSStatefulInstance {
+	return &ElastigroupAWSStatefulInstance{
+		GroupID:            &groupID,
+		StatefulInstanceID: &statefulInstanceID,
+		StatefulInstance:   statefulInstance,
+	}
+}
+
+func NewElastigroupAWSStatefulInstanceResource(fieldsMap map[FieldName]*GenericField) *ElastigroupAWSStatefulInstanceTerraformResource {
+	return &ElastigroupAWSStatefulInstanceTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ElastigroupAWSStatefulInstanceResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *ElastigroupAWSStatefulInstanceTerraformResource) OnRead(
+	statefulInstance *ElastigroupAWSStatefulInstance,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(statefulInstance, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (res *ElastigroupAWSStatefulInstanceTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*ElastigroupAWSStatefulInstance, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	statefulInstance := &ElastigroupAWSStatefulInstance{}
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(statefulInstance, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return statefulInstance, nil
+}
@@ -0,0 +1,111 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	MultaiBlueGreenResourceName ResourceName = "spotinst_multai_blue_green"
+)
+
+var MultaiBlueGreenResource *MultaiBlueGreenTerraformResource
+
+type MultaiBlueGreenTerraformResource struct {
+	GenericResource
+}
+
+// MultaiBlueGreen is the desired state of a blue/green rollout. The API has no
+// such object; it is realized by the weights of two target sets that are
+// attached to the same routing rule.
+type MultaiBlueGreen struct {
+	RoutingRuleID        *string
+	BlueTargetSetID      *string
+	GreenTargetSetID     *string
+	GreenWeight          *int
+	StepSize             *int
+	StepInterval         *int
+	MinHealthyPercentage *int
+	RollbackOnFailure    *bool
+}
+
+func NewMultaiBlueGreenResource(fieldsMap map[FieldName]*GenericField) *MultaiBlueGreenTerraformResource {
+	return &MultaiBlueGreenTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: MultaiBlueGreenResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *MultaiBlueGreenTerraformResource) OnRead(
+	blueGreen *MultaiBlueGreen,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(blueGreen, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (res *MultaiBlueGreenTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*MultaiBlueGreen, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	blueGreen := &MultaiBlueGreen{}
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(blueGreen, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return blueGreen, nil
+}
+
+// OnUpdate returns the full desired state, since a rollout always needs both
+// target sets and every step setting.
+func (res *MultaiBlueGreenTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, *MultaiBlueGreen, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	blueGreen := &MultaiBlueGreen{}
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			hasChanged = true
+		}
+		log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onUpdate(blueGreen, resourceData, meta); err != nil {
+			return false, nil, err
+		}
+	}
+
+	return hasChanged, blueGreen, nil
+}
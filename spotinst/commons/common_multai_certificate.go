@@ -0,0 +1,119 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+)
+
+const (
+	MultaiCertificateResourceName ResourceName = "spotinst_multai_certificate"
+)
+
+var MultaiCertificateResource *MultaiCertificateTerraformResource
+
+type MultaiCertificateTerraformResource struct {
+	GenericResource
+}
+
+type MultaiCertificateWrapper struct {
+	certificate *multai.Certificate
+}
+
+func NewMultaiCertificateResource(fieldMap map[FieldName]*GenericField) *MultaiCertificateTerraformResource {
+	return &MultaiCertificateTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: MultaiCertificateResourceName,
+			fields:       NewGenericFields(fieldMap),
+		},
+	}
+}
+
+func (res *MultaiCertificateTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*multai.Certificate, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	certificateWrapper := NewMultaiCertificateWrapper()
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(certificateWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return certificateWrapper.GetMultaiCertificate(), nil
+}
+
+func (res *MultaiCertificateTerraformResource) OnRead(
+	certificate *multai.Certificate,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	certificateWrapper := NewMultaiCertificateWrapper()
+	certificateWrapper.SetMultaiCertificate(certificate)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(certificateWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (res *MultaiCertificateTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, *multai.Certificate, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	certificateWrapper := NewMultaiCertificateWrapper()
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+			if err := field.onUpdate(certificateWrapper, resourceData, meta); err != nil {
+				return false, nil, err
+			}
+			hasChanged = true
+		}
+	}
+
+	return hasChanged, certificateWrapper.GetMultaiCertificate(), nil
+}
+
+func NewMultaiCertificateWrapper() *MultaiCertificateWrapper {
+	return &MultaiCertificateWrapper{
+		certificate: &multai.Certificate{},
+	}
+}
+
+func (certificateWrapper *MultaiCertificateWrapper) GetMultaiCertificate() *multai.Certificate {
+	return certificateWrapper.certificate
+}
+
+func (certificateWrapper *MultaiCertificateWrapper) SetMultaiCertificate(certificate *multai.Certificate) {
+	certificateWrapper.certificate = certificate
+}
@@ -0,0 +1,102 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+const (
+	MultaiTargetSetAttachmentResourceName ResourceName = "spotinst_multai_target_set_attachment"
+)
+
+var MultaiTargetSetAttachmentResource *MultaiTargetSetAttachmentTerraformResource
+
+type MultaiTargetSetAttachmentTerraformResource struct {
+	GenericResource
+}
+
+// MultaiTargetSetAttachment binds the instances of an Elastigroup or an Ocean
+// launch spec to a target set. The API has no attachment object; the binding
+// is a load balancer entry of the group or launch spec, and the members are
+// the targets the integration registers in the target set.
+type MultaiTargetSetAttachment struct {
+	BalancerID        *string
+	TargetSetID       *string
+	ElastigroupID     *string
+	OceanLaunchSpecID *string
+	DrainingTimeout   *int
+
+	Targets                 []*multai.Target
+	UnregisteredInstanceIDs []string
+}
+
+// NewMultaiTargetSetAttachment wraps the IDs of an existing attachment for
+// Read and Delete. Empty IDs are left unset.
+func NewMultaiTargetSetAttachment(balancerID, targetSetID, elastigroupID, oceanLaunchSpecID string) *MultaiTargetSetAttachment {
+	attachment := &MultaiTargetSetAttachment{
+		BalancerID:  spotinst.String(balancerID),
+		TargetSetID: spotinst.String(targetSetID),
+	}
+	if elastigroupID != "" {
+		attachment.ElastigroupID = spotinst.String(elastigroupID)
+	}
+	if oceanLaunchSpecID != "" {
+		attachment.OceanLaunchSpecID = spotinst.String(oceanLaunchSpecID)
+	}
+	return attachment
+}
+
+func NewMultaiTargetSetAttachmentResource(fieldsMap map[FieldName]*GenericField) *MultaiTargetSetAttachmentTerraformResource {
+	return &MultaiTargetSetAttachmentTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: MultaiTargetSetAttachmentResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *MultaiTargetSetAttachmentTerraformResource) OnRead(
+	attachment *MultaiTargetSetAttachment,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(attachment, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (res *MultaiTargetSetAttachmentTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*MultaiTargetSetAttachment, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	attachment := &MultaiTargetSetAttachment{}
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(attachment, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return attachment, nil
+}
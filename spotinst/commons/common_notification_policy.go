@@ -0,0 +1,131 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	NotificationPolicyResourceName ResourceName = "spotinst_notification_policy"
+)
+
+var NotificationPolicyResource *NotificationPolicyTerraformResource
+
+type NotificationPolicyTerraformResource struct {
+	GenericResource
+}
+
+// NotificationPolicy is the desired state of a notification policy. The API has
+// no policy object; a policy is realized as a set of subscriptions, one per
+// matching resource, event type and endpoint.
+type NotificationPolicy struct {
+	ResourceType  *string
+	TagSelectors  []*NotificationPolicyTagSelector
+	EventTypes    []string
+	Endpoints     []*NotificationPolicyEndpoint
+	Format        map[string]interface{}
+	Subscriptions []*NotificationPolicySubscription
+}
+
+type NotificationPolicyTagSelector struct {
+	Key   *string
+	Value *string
+}
+
+type NotificationPolicyEndpoint struct {
+	Protocol      *string
+	Endpoint      *string
+	SigningSecret *string
+
+	// Format overrides the policy format for endpoints that expect a fixed payload.
+	Format map[string]interface{}
+}
+
+type NotificationPolicySubscription struct {
+	ID         *string
+	ResourceID *string
+	EventType  *string
+	Protocol   *string
+	Endpoint   *string
+}
+
+func NewNotificationPolicyResource(fieldsMap map[FieldName]*GenericField) *NotificationPolicyTerraformResource {
+	return &NotificationPolicyTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: NotificationPolicyResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *NotificationPolicyTerraformResource) OnRead(
+	policy *NotificationPolicy,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(policy, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (res *NotificationPolicyTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*NotificationPolicy, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	policy := &NotificationPolicy{}
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(policy, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return policy, nil
+}
+
+// OnUpdate returns the full desired policy, since every change is reconciled
+// against the complete set of underlying subscriptions.
+func (res *NotificationPolicyTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, *NotificationPolicy, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	policy := &NotificationPolicy{}
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			hasChanged = true
+		}
+		log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onUpdate(policy, resourceData, meta); err != nil {
+			return false, nil, err
+		}
+	}
+
+	return hasChanged, policy, nil
+}
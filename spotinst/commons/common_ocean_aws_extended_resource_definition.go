@@ -10,9 +10,13 @@ import (
 
 const (
 	OceanAWSExtendedResourceDefinitionResourceName ResourceName = "spotinst_ocean_aws_extended_resource_definition"
+	OceanGKEExtendedResourceDefinitionResourceName ResourceName = "spotinst_ocean_gke_extended_resource_definition"
 )
 
-var OceanAWSExtendedResourceDefinitionResource *OceanAWSExtendedResourceDefinitionTerraformResource
+var (
+	OceanAWSExtendedResourceDefinitionResource *OceanAWSExtendedResourceDefinitionTerraformResource
+	OceanGKEExtendedResourceDefinitionResource *OceanAWSExtendedResourceDefinitionTerraformResource
+)
 
 type OceanAWSExtendedResourceDefinitionTerraformResource struct {
 	GenericResource
@@ -32,6 +36,18 @@ func NewOceanAWSExtendedResourceDefinitionResource(fieldMap map[FieldName]*Gener
 	}
 }
 
+// NewOceanGKEExtendedResourceDefinitionResource creates a new OceanGKEExtendedResourceDefinition resource.
+// Extended resource definitions are not tied to a cloud provider, so it shares the
+// definition object and fields with the AWS resource.
+func NewOceanGKEExtendedResourceDefinitionResource(fieldMap map[FieldName]*GenericField) *OceanAWSExtendedResourceDefinitionTerraformResource {
+	return &OceanAWSExtendedResourceDefinitionTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: OceanGKEExtendedResourceDefinitionResourceName,
+			fields:       NewGenericFields(fieldMap),
+		},
+	}
+}
+
 // OnCreate is called when creating a new resource block and returns a new OceanAWSExtendedResourceDefinition or an error.
 func (res *OceanAWSExtendedResourceDefinitionTerraformResource) OnCreate(
 	resourceData *schema.ResourceData,
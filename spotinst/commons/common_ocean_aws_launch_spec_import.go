@@ -0,0 +1,124 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+)
+
+const (
+	OceanAWSLaunchSpecImportResourceName ResourceName = "spotinst_ocean_aws_launch_spec_import"
+)
+
+var OceanAWSLaunchSpecImportResource *OceanAWSLaunchSpecImportTerraformResource
+
+type OceanAWSLaunchSpecImportTerraformResource struct {
+	GenericResource
+}
+
+type AWSLaunchSpecImportWrapper struct {
+	launchSpec *aws.LaunchSpec
+}
+
+func NewOceanAWSLaunchSpecImportResource(fieldsMap map[FieldName]*GenericField) *OceanAWSLaunchSpecImportTerraformResource {
+	return &OceanAWSLaunchSpecImportTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: OceanAWSLaunchSpecImportResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *OceanAWSLaunchSpecImportTerraformResource) OnCreate(
+	importedLaunchSpec *aws.LaunchSpec,
+	resourceData *schema.ResourceData,
+	meta interface{}) (*aws.LaunchSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	launchSpecWrapper := NewAWSLaunchSpecImportWrapper()
+
+	if importedLaunchSpec != nil {
+		launchSpecWrapper.SetLaunchSpec(importedLaunchSpec)
+	}
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(launchSpecWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return launchSpecWrapper.GetLaunchSpec(), nil
+}
+
+func (res *OceanAWSLaunchSpecImportTerraformResource) OnRead(
+	launchSpec *aws.LaunchSpec,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	launchSpecWrapper := NewAWSLaunchSpecImportWrapper()
+	launchSpecWrapper.SetLaunchSpec(launchSpec)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(launchSpecWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (res *OceanAWSLaunchSpecImportTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, *aws.LaunchSpec, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	launchSpecWrapper := NewAWSLaunchSpecImportWrapper()
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+			if err := field.onUpdate(launchSpecWrapper, resourceData, meta); err != nil {
+				return false, nil, err
+			}
+			hasChanged = true
+		}
+	}
+
+	return hasChanged, launchSpecWrapper.GetLaunchSpec(), nil
+}
+
+func NewAWSLaunchSpecImportWrapper() *AWSLaunchSpecImportWrapper {
+	return &AWSLaunchSpecImportWrapper{
+		launchSpec: &aws.LaunchSpec{},
+	}
+}
+
+func (launchSpecImportWrapper *AWSLaunchSpecImportWrapper) GetLaunchSpec() *aws.LaunchSpec {
+	return launchSpecImportWrapper.launchSpec
+}
+
+func (launchSpecImportWrapper *AWSLaunchSpecImportWrapper) SetLaunchSpec(launchSpecImport *aws.LaunchSpec) {
+	launchSpecImportWrapper.launchSpec = launchSpecImport
+}
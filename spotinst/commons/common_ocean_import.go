@@ -0,0 +1,66 @@
+package commons
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Ocean clusters can be created from an existing cluster, whose launch
+// configuration fills the fields that are not set in the configuration. The
+// imported values are only sent in the create request and are not kept in the
+// state, so that they never show as a diff against the configuration.
+
+// FillOceanImportedFields sets the imported value of every field that is not
+// set in the configuration, and returns the fields it set. The fields must be
+// cleared with ClearOceanImportedFields once the create request was built.
+func FillOceanImportedFields(resourceData *schema.ResourceData, imported map[string]interface{}) ([]string, error) {
+	var fields []string
+	for field, value := range imported {
+		if _, ok := resourceData.GetOk(field); ok {
+			continue
+		}
+		if err := resourceData.Set(field, value); err != nil {
+			return nil, fmt.Errorf(string(FailureFieldReadPattern), field, err)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// ClearOceanImportedFields resets the fields set by FillOceanImportedFields.
+func ClearOceanImportedFields(resourceData *schema.ResourceData, fields []string) error {
+	for _, field := range fields {
+		if err := resourceData.Set(field, nil); err != nil {
+			return fmt.Errorf(string(FailureFieldReadPattern), field, err)
+		}
+	}
+	return nil
+}
+
+// IsOceanImportedField reports whether the value of field is left to the
+// import set in importField, in which case it is not read into the state.
+func IsOceanImportedField(resourceData *schema.ResourceData, importField, field string) bool {
+	if _, ok := resourceData.GetOk(importField); !ok {
+		return false
+	}
+	_, ok := resourceData.GetOk(field)
+	return !ok
+}
+
+// RequireOceanFieldsUnlessImported returns an error for the first of fields
+// that is not set while importField is not set either.
+func RequireOceanFieldsUnlessImported(diff *schema.ResourceDiff, importField string, fields ...string) error {
+	if _, ok := diff.GetOk(importField); ok {
+		return nil
+	}
+	for _, field := range fields {
+		if !diff.NewValueKnown(field) {
+			continue
+		}
+		if _, ok := diff.GetOk(field); !ok {
+			return fmt.Errorf("%s is required unless %s is set", field, importField)
+		}
+	}
+	return nil
+}
@@ -113,6 +113,7 @@ func NewSparkClusterWrapper() *SparkClusterWrapper {
 				Webhook:       &spark.WebhookConfig{},
 				Compute:       &spark.ComputeConfig{},
 				LogCollection: &spark.LogCollectionConfig{},
+				Spark:         &spark.SparkConfig{},
 			},
 		},
 	}
@@ -0,0 +1,98 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/spark"
+)
+
+const (
+	OceanSparkApplicationResourceName ResourceName = "spotinst_ocean_spark_application"
+)
+
+var OceanSparkApplicationResource *OceanSparkApplicationTerraformResource
+
+type OceanSparkApplicationTerraformResource struct {
+	GenericResource
+}
+
+type SparkApplicationWrapper struct {
+	app *spark.Application
+}
+
+func NewOceanSparkApplicationResource(fieldsMap map[FieldName]*GenericField) *OceanSparkApplicationTerraformResource {
+	return &OceanSparkApplicationTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: OceanSparkApplicationResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *OceanSparkApplicationTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*spark.Application, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	appWrapper := NewSparkApplicationWrapper()
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(appWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return appWrapper.GetApplication(), nil
+}
+
+func (res *OceanSparkApplicationTerraformResource) OnRead(
+	app *spark.Application,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	appWrapper := NewSparkApplicationWrapper()
+	appWrapper.SetApplication(app)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(appWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewSparkApplicationWrapper() *SparkApplicationWrapper {
+	return &SparkApplicationWrapper{
+		app: &spark.Application{
+			ConfigOverrides: &spark.ConfigOverrides{
+				Driver:   &spark.PodSpec{},
+				Executor: &spark.PodSpec{},
+			},
+		},
+	}
+}
+
+func (appWrapper *SparkApplicationWrapper) GetApplication() *spark.Application {
+	return appWrapper.app
+}
+
+func (appWrapper *SparkApplicationWrapper) SetApplication(app *spark.Application) {
+	appWrapper.app = app
+}
@@ -2,6 +2,7 @@ package commons
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"math/rand"
 	"time"
@@ -66,6 +67,35 @@ func NewGenericField(
 	}
 }
 
+// NewComputedStringField returns a read-only field holding the string that get
+// returns for the resource object being read.
+func NewComputedStringField(
+	resourceAffinity ResourceAffinity,
+	fieldName FieldName,
+	get func(resourceObject interface{}) *string,
+) *GenericField {
+
+	return NewGenericField(
+		resourceAffinity,
+		fieldName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			value := ""
+			if v := get(resourceObject); v != nil {
+				value = *v
+			}
+			if err := resourceData.Set(string(fieldName), value); err != nil {
+				return fmt.Errorf(string(FailureFieldReadPattern), string(fieldName), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+}
+
 func NewGenericFields(fieldsMap map[FieldName]*GenericField) *GenericFields {
 	var schemaMap = make(map[string]*schema.Schema)
 
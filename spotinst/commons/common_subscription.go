@@ -10,6 +10,8 @@ import (
 
 const (
 	SubscriptionResourceName ResourceName = "spotinst_subscription"
+
+	SubscriptionEventTypesDataSourceName ResourceName = "spotinst_subscription_event_types"
 )
 
 var SubscriptionResource *SubscriptionTerraformResource
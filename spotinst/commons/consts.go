@@ -29,7 +29,8 @@ const (
 	OceanAWSLaunchConfiguration ResourceAffinity = "Ocean_AWS_Launch_Configuration"
 	OceanAwsLogging             ResourceAffinity = "Ocean_AWS_Logging"
 
-	OceanAWSLaunchSpec ResourceAffinity = "Ocean_AWS_Launch_Spec"
+	OceanAWSLaunchSpec       ResourceAffinity = "Ocean_AWS_Launch_Spec"
+	OceanAWSLaunchSpecImport ResourceAffinity = "Ocean_AWS_Launch_Spec_Import"
 
 	OceanAWSExtendedResourceDefinition ResourceAffinity = "Ocean_AWS_Extended_Resource_Definition"
 
@@ -39,6 +40,7 @@ const (
 	OceanGKEImportAutoScaler          ResourceAffinity = "Ocean_GKE_Import_Auto_Scaler"
 	OceanGKEImportLaunchSpecification ResourceAffinity = "Ocean_GKE_Import_Launch_Specification"
 	OceanGKEImportStrategy            ResourceAffinity = "Ocean_GKE_Import_Strategy"
+	OceanGKEImportLogging             ResourceAffinity = "Ocean_GKE_Import_Logging"
 
 	OceanGKEInstanceTypes      ResourceAffinity = "Ocean_GKE_Instance_Types"
 	OceanGKEAutoScaling        ResourceAffinity = "Ocean_GKE_Auto_Scaling"
@@ -60,6 +62,7 @@ const (
 	OceanAKSLoadBalancers       ResourceAffinity = "Ocean_AKS_Load_Balancers_Config"
 	OceanAKSNetwork             ResourceAffinity = "Ocean_AKS_Network"
 	OceanAKSVMSizes             ResourceAffinity = "Ocean_AKS_VMSizes"
+	OceanAKSLogging             ResourceAffinity = "Ocean_AKS_Logging"
 
 	OceanAKSVirtualNodeGroup                    ResourceAffinity = "Ocean_AKS_virtual_node_group"
 	OceanAKSVirtualNodeGroupAutoScaling         ResourceAffinity = "Ocean_AKS_virtual_node_group_Auto_Scaling"
@@ -77,9 +80,11 @@ const (
 	OceanECSLaunchSpec ResourceAffinity = "Ocean_ECS_Launch_Spec"
 
 	ElastigroupAWS                    ResourceAffinity = "Elastigroup_AWS"
+	ElastigroupAWSImport              ResourceAffinity = "Elastigroup_AWS_Import"
 	ElastigroupAWSInstanceType        ResourceAffinity = "Elastigroup_AWS_Instance_Type"
 	ElastigroupAWSStrategy            ResourceAffinity = "Elastigroup_AWS_Strategy"
 	ElastigroupAWSStateful            ResourceAffinity = "Elastigroup_AWS_Stateful"
+	ElastigroupAWSStatefulInstance    ResourceAffinity = "Elastigroup_AWS_Stateful_Instance"
 	ElastigroupAWSLaunchConfiguration ResourceAffinity = "Elastigroup_AWS_Launch_Configuration"
 	ElastigroupAWSNetworkInterface    ResourceAffinity = "Elastigroup_AWS_Network_Interface"
 	ElastigroupAWSScheduledTask       ResourceAffinity = "Elastigroup_AWS_Scheduled_Task"
@@ -137,16 +142,23 @@ const (
 	MRScalerAWSTerminationPolicies ResourceAffinity = "MRScaler_AWS_Termination_Policies"
 
 	MultaiBalancer    ResourceAffinity = "Multai_Balancer"
+	MultaiBlueGreen   ResourceAffinity = "Multai_Blue_Green"
+	MultaiCertificate ResourceAffinity = "Multai_Certificate"
 	MultaiDeployment  ResourceAffinity = "Multai_Deployment"
 	MultaiListener    ResourceAffinity = "Multai_Listener"
+	MultaiMiddleware  ResourceAffinity = "Multai_Middleware"
 	MultaiRoutingRule ResourceAffinity = "Multai_Routing_Rule"
 	MultaiTarget      ResourceAffinity = "Multai_Target"
 	MultaiTargetSet   ResourceAffinity = "Multai_Target_Set"
 
+	MultaiTargetSetAttachment ResourceAffinity = "Multai_Target_Set_Attachment"
+
 	DataIntegration ResourceAffinity = "Data_Integration"
 
 	HealthCheck ResourceAffinity = "Health_Check"
 
+	NotificationPolicy ResourceAffinity = "Notification_Policy"
+
 	SuspendProcesses ResourceAffinity = "Suspend_Processes"
 
 	StatefulNodeAzure                    ResourceAffinity = "Stateful_Node_Azure"
@@ -168,6 +180,10 @@ const (
 	OceanSparkWebhook       ResourceAffinity = "Ocean_Spark_Webhook"
 	OceanSparkCompute       ResourceAffinity = "Ocean_Spark_Compute"
 	OceanSparkLogCollection ResourceAffinity = "Ocean_Spark_Log_Collection"
+	OceanSparkSpark         ResourceAffinity = "Ocean_Spark_Spark"
+
+	OceanSparkVirtualNodeGroup ResourceAffinity = "Ocean_Spark_Virtual_Node_Group"
+	OceanSparkApplication      ResourceAffinity = "Ocean_Spark_Application"
 
 	ResourceFieldOnRead   LogFormat = "onRead() -> %s -> %s"
 	ResourceFieldOnCreate LogFormat = "onCreate() -> %s -> %s"
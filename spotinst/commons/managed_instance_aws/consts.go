@@ -11,4 +11,27 @@ const (
 	ManagedInstanceAction commons.FieldName = "managed_instance_action"
 	ActionType            commons.FieldName = "type"
 	// ----------------------------------------
+
+	// - Import Instance ----------------------
+	ImportInstance       commons.FieldName = "import_instance"
+	OriginalInstanceID   commons.FieldName = "original_instance_id"
+	StopOriginalInstance commons.FieldName = "stop_original_instance"
+	// ----------------------------------------
+
+	WaitForState        commons.FieldName = "wait_for_state"
+	WaitForStateTimeout commons.FieldName = "wait_for_state_timeout"
+
+	// - Instance Status ----------------------
+	Status            commons.FieldName = "status"
+	InstanceID        commons.FieldName = "instance_id"
+	InstancePrivateIP commons.FieldName = "instance_private_ip"
+	InstancePublicIP  commons.FieldName = "instance_public_ip"
+	InstanceLifeCycle commons.FieldName = "instance_life_cycle"
+	// ----------------------------------------
+)
+
+const (
+	StatusActive = "ACTIVE"
+	StatusPaused = "PAUSED"
+	StatusError  = "ERROR"
 )
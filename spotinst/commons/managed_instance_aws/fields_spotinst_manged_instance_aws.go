@@ -2,8 +2,10 @@ package managed_instance_aws
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 )
@@ -142,4 +144,109 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[ImportInstance] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		ImportInstance,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			ForceNew:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"image_id"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(OriginalInstanceID): {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(instanceIDRegex, "must be an EC2 instance ID"),
+					},
+
+					string(StopOriginalInstance): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForState] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		WaitForState,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForStateTimeout] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		WaitForStateTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      900,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Status] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		Status,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[InstanceID] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		InstanceID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[InstancePrivateIP] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		InstancePrivateIP,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[InstancePublicIP] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		InstancePublicIP,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[InstanceLifeCycle] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		InstanceLifeCycle,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
 }
+
+var instanceIDRegex = regexp.MustCompile(`^i-[0-9a-f]+$`)
@@ -10,6 +10,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons/managed_instance_aws"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -187,9 +188,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		ImageID,
 		&schema.Schema{
 			Type:     schema.TypeString,
-			Required: true,
+			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// The image of an imported instance is built by the import, and is
+			// not part of the configuration.
+			if _, ok := resourceData.GetOk(string(managed_instance_aws.ImportInstance)); ok {
+				return nil
+			}
 			miWrapper := resourceObject.(*commons.MangedInstanceAWSWrapper)
 			managedInstance := miWrapper.GetManagedInstance()
 			var value *string = nil
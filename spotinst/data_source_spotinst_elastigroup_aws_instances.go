@@ -0,0 +1,145 @@
+package spotinst
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// HealthStatusInsufficientData is reported for instances the healthiness API
+// has no data about yet, e.g. instances that are still launching.
+const HealthStatusInsufficientData = "INSUFFICIENT_DATA"
+
+func dataSourceSpotinstElastigroupAWSInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstElastigroupAWSInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"health_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstElastigroupAWSInstancesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID := resourceData.Get("group_id").(string)
+	log.Printf(string(commons.ResourceOnRead),
+		commons.ElastigroupAWSInstancesDataSourceName, groupID)
+
+	svc := meta.(*Client).elastigroup.CloudProviderAWS()
+
+	status, err := svc.Status(ctx, &aws.StatusGroupInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return diag.Errorf("failed to read group status: %s", err)
+	}
+
+	healthiness, err := svc.GetInstanceHealthiness(ctx, &aws.GetInstanceHealthinessInput{GroupID: spotinst.String(groupID)})
+	if err != nil {
+		return diag.Errorf("failed to read instance healthiness: %s", err)
+	}
+
+	health := make(map[string]*aws.InstanceHealth, len(healthiness.Instances))
+	for _, item := range healthiness.Instances {
+		health[spotinst.StringValue(item.InstanceID)] = item
+	}
+
+	instances := status.Instances
+	sort.Slice(instances, func(i, j int) bool {
+		return spotinst.StringValue(instances[i].ID) < spotinst.StringValue(instances[j].ID)
+	})
+
+	if err := resourceData.Set("instances", flattenGroupInstances(instances, health)); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), "instances", err)
+	}
+
+	resourceData.SetId(groupID)
+	return nil
+}
+
+func flattenGroupInstances(instances []*aws.Instance, health map[string]*aws.InstanceHealth) []interface{} {
+	result := make([]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		m := make(map[string]interface{})
+		m["instance_id"] = spotinst.StringValue(instance.ID)
+		m["instance_type"] = spotinst.StringValue(instance.InstanceType)
+		m["availability_zone"] = spotinst.StringValue(instance.AvailabilityZone)
+		m["private_ip"] = spotinst.StringValue(instance.PrivateIP)
+		m["public_ip"] = spotinst.StringValue(instance.PublicIP)
+		m["status"] = spotinst.StringValue(instance.Status)
+		m["health_status"] = HealthStatusInsufficientData
+
+		// Only the healthiness API reports the lifecycle; until it has data
+		// about the instance, it is derived from the spot request.
+		m["lifecycle"] = "OD"
+		if instance.SpotRequestID != nil {
+			m["lifecycle"] = "SPOT"
+		}
+
+		if item, ok := health[spotinst.StringValue(instance.ID)]; ok {
+			if item.LifeCycle != nil {
+				m["lifecycle"] = spotinst.StringValue(item.LifeCycle)
+			}
+			if item.HealthStatus != nil {
+				m["health_status"] = spotinst.StringValue(item.HealthStatus)
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
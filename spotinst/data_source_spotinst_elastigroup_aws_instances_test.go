@@ -0,0 +1,38 @@
+package spotinst
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func TestAccSpotinstDataSourceElastigroupAWSInstances(t *testing.T) {
+	dataSourceName := fmt.Sprintf("data.%v.foo", string(commons.ElastigroupAWSInstancesDataSourceName))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceElastigroupAWSInstances,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "sig-9f6d7870"),
+					resource.TestMatchResourceAttr(dataSourceName, "instances.0.instance_id", regexp.MustCompile(`^i-`)),
+					resource.TestMatchResourceAttr(dataSourceName, "instances.0.lifecycle", regexp.MustCompile(`^(SPOT|OD)$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "instances.0.health_status", regexp.MustCompile(`^(HEALTHY|UNHEALTHY|INSUFFICIENT_DATA)$`)),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceElastigroupAWSInstances = `
+data "` + string(commons.ElastigroupAWSInstancesDataSourceName) + `" "foo" {
+  provider = "aws"
+  group_id = "sig-9f6d7870"
+}
+`
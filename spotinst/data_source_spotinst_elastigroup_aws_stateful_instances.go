@@ -0,0 +1,155 @@
+package spotinst
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func dataSourceSpotinstElastigroupAWSStatefulInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstElastigroupAWSStatefulInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"stateful_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stateful_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"image_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"volume_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"devices": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"device_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"volume_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"private_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstElastigroupAWSStatefulInstancesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupID := resourceData.Get("group_id").(string)
+	log.Printf(string(commons.ResourceOnRead),
+		commons.ElastigroupAWSStatefulInstancesDataSourceName, groupID)
+
+	statefulInstances, err := listStatefulInstances(ctx, meta.(*Client).elastigroup.CloudProviderAWS(), groupID)
+	if err != nil {
+		return diag.Errorf("failed to list stateful instances: %s", err)
+	}
+	sort.Slice(statefulInstances, func(i, j int) bool {
+		return spotinst.StringValue(statefulInstances[i].StatefulInstanceID) <
+			spotinst.StringValue(statefulInstances[j].StatefulInstanceID)
+	})
+
+	if err := resourceData.Set("stateful_instances", flattenStatefulInstances(statefulInstances)); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), "stateful_instances", err)
+	}
+
+	privateIPs := make([]string, 0, len(statefulInstances))
+	for _, statefulInstance := range statefulInstances {
+		if statefulInstance.PrivateIP != nil {
+			privateIPs = append(privateIPs, spotinst.StringValue(statefulInstance.PrivateIP))
+		}
+	}
+	if err := resourceData.Set("private_ips", privateIPs); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), "private_ips", err)
+	}
+
+	resourceData.SetId(groupID)
+	return nil
+}
+
+func flattenStatefulInstances(statefulInstances []*aws.StatefulInstance) []interface{} {
+	result := make([]interface{}, 0, len(statefulInstances))
+	for _, statefulInstance := range statefulInstances {
+		volumeIDs := make([]string, 0, len(statefulInstance.Devices))
+		devices := make([]interface{}, 0, len(statefulInstance.Devices))
+		for _, device := range statefulInstance.Devices {
+			if device.VolumeID != nil {
+				volumeIDs = append(volumeIDs, spotinst.StringValue(device.VolumeID))
+			}
+			devices = append(devices, map[string]interface{}{
+				"device_name": spotinst.StringValue(device.DeviceName),
+				"volume_id":   spotinst.StringValue(device.VolumeID),
+				"snapshot_id": spotinst.StringValue(device.SnapshotID),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"stateful_instance_id": spotinst.StringValue(statefulInstance.StatefulInstanceID),
+			"state":                spotinst.StringValue(statefulInstance.State),
+			"instance_id":          spotinst.StringValue(statefulInstance.InstanceID),
+			"private_ip":           spotinst.StringValue(statefulInstance.PrivateIP),
+			"image_id":             spotinst.StringValue(statefulInstance.ImageID),
+			"volume_ids":           volumeIDs,
+			"devices":              devices,
+		})
+	}
+	return result
+}
@@ -0,0 +1,40 @@
+package spotinst
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func TestAccSpotinstDataSourceElastigroupAWSStatefulInstances(t *testing.T) {
+	dataSourceName := fmt.Sprintf("data.%v.foo", string(commons.ElastigroupAWSStatefulInstancesDataSourceName))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceElastigroupAWSStatefulInstances,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "sig-9f6d7870"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_instances.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "stateful_instances.0.stateful_instance_id", "ssi-b1f2c3d4"),
+					resource.TestMatchResourceAttr(dataSourceName, "stateful_instances.0.instance_id", regexp.MustCompile(`^i-`)),
+					resource.TestMatchResourceAttr(dataSourceName, "stateful_instances.0.volume_ids.0", regexp.MustCompile(`^vol-`)),
+					resource.TestCheckResourceAttr(dataSourceName, "private_ips.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceElastigroupAWSStatefulInstances = `
+data "` + string(commons.ElastigroupAWSStatefulInstancesDataSourceName) + `" "foo" {
+  provider = "aws"
+  group_id = "sig-9f6d7870"
+}
+`
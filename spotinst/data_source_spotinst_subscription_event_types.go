@@ -0,0 +1,47 @@
+package spotinst
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+	subscriptionPackage "github.com/spotinst/terraform-provider-spotinst/spotinst/subscription"
+)
+
+func dataSourceSpotinstSubscriptionEventTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstSubscriptionEventTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(subscriptionPackage.ResourceTypes(), false),
+			},
+
+			"event_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstSubscriptionEventTypesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resourceType := resourceData.Get("resource_type").(string)
+	log.Printf(string(commons.ResourceOnRead),
+		commons.SubscriptionEventTypesDataSourceName, resourceType)
+
+	eventTypes := subscriptionPackage.EventTypesByResourceType[resourceType]
+	if err := resourceData.Set("event_types", eventTypes); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), "event_types", err)
+	}
+
+	resourceData.SetId(resourceType)
+	return nil
+}
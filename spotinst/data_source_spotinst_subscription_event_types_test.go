@@ -0,0 +1,37 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func TestAccSpotinstDataSourceSubscriptionEventTypes(t *testing.T) {
+	dataSourceName := fmt.Sprintf("data.%v.ocean", string(commons.SubscriptionEventTypesDataSourceName))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceSubscriptionEventTypes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "ocean"),
+					resource.TestCheckResourceAttr(dataSourceName, "event_types.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "event_types.0", "CLUSTER_ROLL_FINISHED"),
+					resource.TestCheckResourceAttr(dataSourceName, "event_types.1", "GROUP_ROLL_FAILED"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceSubscriptionEventTypes = `
+data "` + string(commons.SubscriptionEventTypesDataSourceName) + `" "ocean" {
+  provider      = "aws"
+  resource_type = "ocean"
+}
+`
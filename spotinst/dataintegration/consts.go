@@ -5,8 +5,32 @@ import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 const (
 	DataIntegrationName commons.FieldName = "name"
 	S3                  commons.FieldName = "s3"
+	GCS                 commons.FieldName = "gcs"
+	Vendor              commons.FieldName = "vendor"
 	Status              commons.FieldName = "status"
+	VerificationStatus  commons.FieldName = "verification_status"
 
 	BucketName commons.FieldName = "bucket_name"
 	SubDir     commons.FieldName = "subdir"
+
+	WaitForVerification        commons.FieldName = "wait_for_verification"
+	WaitForVerificationTimeout commons.FieldName = "wait_for_verification_timeout"
+)
+
+const (
+	VendorS3  = "s3"
+	VendorGCS = "gcs"
 )
+
+const (
+	StatusEnabled  = "enabled"
+	StatusDisabled = "disabled"
+	StatusVerified = "verified"
+	StatusFailed   = "failed"
+)
+
+// VendorFields maps every supported vendor to the nested block holding its configuration.
+var VendorFields = map[string]commons.FieldName{
+	VendorS3:  S3,
+	VendorGCS: GCS,
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/dataintegration/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -44,13 +45,48 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[Vendor] = commons.NewGenericField(
+		commons.DataIntegration,
+		Vendor,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{VendorS3, VendorGCS}, false),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
+			di := diWrapper.GetDataIntegration()
+			var value *string = nil
+			if di.Vendor != nil {
+				value = di.Vendor
+			}
+			if err := resourceData.Set(string(Vendor), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Vendor), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
+			di := diWrapper.GetDataIntegration()
+			if v, ok := resourceData.GetOk(string(Vendor)); ok {
+				di.SetVendor(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
 	fieldsMap[S3] = commons.NewGenericField(
 		commons.DataIntegration,
 		S3,
 		&schema.Schema{
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{string(GCS)},
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 
@@ -70,8 +106,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
 			di := diWrapper.GetDataIntegration()
 			var value []interface{} = nil
-			if di.Config != nil && di.Vendor != nil {
-				value = flattenS3(di.Config)
+			if di.Config != nil && spotinst.StringValue(di.Vendor) == VendorS3 {
+				value = flattenBucketConfig(di.Config)
 			}
 			if err := resourceData.Set(string(S3), value); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(S3), err)
@@ -82,11 +118,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
 			di := diWrapper.GetDataIntegration()
 			if value, ok := resourceData.GetOk(string(S3)); ok {
-				if config, err := expandS3(value); err != nil {
+				if config, err := expandBucketConfig(value); err != nil {
 					return err
 				} else {
 					di.SetConfig(config)
-					di.SetVendor(spotinst.String("s3"))
+					di.SetVendor(spotinst.String(VendorS3))
 				}
 			}
 			return nil
@@ -94,16 +130,79 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
 			di := diWrapper.GetDataIntegration()
-			var value *aws.Config = nil
 			if v, ok := resourceData.GetOk(string(S3)); ok {
-				if config, err := expandS3(v); err != nil {
+				if config, err := expandBucketConfig(v); err != nil {
 					return err
 				} else {
-					value = config
+					di.SetConfig(config)
+					di.SetVendor(spotinst.String(VendorS3))
+				}
+			}
+			return nil
+		},
+
+		nil,
+	)
+
+	fieldsMap[GCS] = commons.NewGenericField(
+		commons.DataIntegration,
+		GCS,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{string(S3)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+
+					string(BucketName): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(SubDir): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
+			di := diWrapper.GetDataIntegration()
+			var value []interface{} = nil
+			if di.Config != nil && spotinst.StringValue(di.Vendor) == VendorGCS {
+				value = flattenBucketConfig(di.Config)
+			}
+			if err := resourceData.Set(string(GCS), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(GCS), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
+			di := diWrapper.GetDataIntegration()
+			if value, ok := resourceData.GetOk(string(GCS)); ok {
+				if config, err := expandBucketConfig(value); err != nil {
+					return err
+				} else {
+					di.SetConfig(config)
+					di.SetVendor(spotinst.String(VendorGCS))
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
+			di := diWrapper.GetDataIntegration()
+			if v, ok := resourceData.GetOk(string(GCS)); ok {
+				if config, err := expandBucketConfig(v); err != nil {
+					return err
+				} else {
+					di.SetConfig(config)
+					di.SetVendor(spotinst.String(VendorGCS))
 				}
 			}
-			di.SetConfig(value)
-			di.SetVendor(spotinst.String("s3"))
 			return nil
 		},
 
@@ -114,18 +213,20 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.DataIntegration,
 		Status,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{StatusEnabled, StatusDisabled}, false),
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
 			di := diWrapper.GetDataIntegration()
-			var value *string = nil
-			if di.Status != nil {
-				value = di.Status
-			}
-			if err := resourceData.Set(string(Status), value); err != nil {
-				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Status), err)
+			// The API reports the verification result in the same field, so only
+			// the user-settable values are read back into `status`.
+			if value := spotinst.StringValue(di.Status); value == StatusEnabled || value == StatusDisabled {
+				if err := resourceData.Set(string(Status), value); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Status), err)
+				}
 			}
 			return nil
 		},
@@ -135,14 +236,64 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
 			di := diWrapper.GetDataIntegration()
-			di.SetStatus(spotinst.String(resourceData.Get(string(Status)).(string)))
+			if resourceData.HasChange(string(Status)) {
+				if v, ok := resourceData.GetOk(string(Status)); ok {
+					di.SetStatus(spotinst.String(v.(string)))
+				}
+			}
 			return nil
 		},
 		nil,
 	)
+
+	fieldsMap[VerificationStatus] = commons.NewGenericField(
+		commons.DataIntegration,
+		VerificationStatus,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			diWrapper := resourceObject.(*commons.DataIntegrationWrapper)
+			di := diWrapper.GetDataIntegration()
+			var value *string = nil
+			if di.Status != nil {
+				value = di.Status
+			}
+			if err := resourceData.Set(string(VerificationStatus), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(VerificationStatus), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[WaitForVerification] = commons.NewGenericField(
+		commons.DataIntegration,
+		WaitForVerification,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForVerificationTimeout] = commons.NewGenericField(
+		commons.DataIntegration,
+		WaitForVerificationTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
 }
 
-func flattenS3(config *aws.Config) []interface{} {
+func flattenBucketConfig(config *aws.Config) []interface{} {
 	m := make(map[string]interface{})
 	m[string(BucketName)] = spotinst.StringValue(config.BucketName)
 	m[string(SubDir)] = spotinst.StringValue(config.SubDir)
@@ -150,21 +301,39 @@ func flattenS3(config *aws.Config) []interface{} {
 	return []interface{}{m}
 }
 
-func expandS3(data interface{}) (*aws.Config, error) {
+func expandBucketConfig(data interface{}) (*aws.Config, error) {
 	if list := data.([]interface{}); len(list) > 0 {
-		s3 := &aws.Config{}
+		config := &aws.Config{}
 		if list != nil && list[0] != nil {
 			m := list[0].(map[string]interface{})
 
 			if v, ok := m[string(BucketName)].(string); ok && v != "" {
-				s3.SetBucketName(spotinst.String(v))
+				config.SetBucketName(spotinst.String(v))
 			}
 
 			if v, ok := m[string(SubDir)].(string); ok && v != "" {
-				s3.SetSubDir(spotinst.String(v))
+				config.SetSubDir(spotinst.String(v))
 			}
 		}
-		return s3, nil
+		return config, nil
 	}
 	return nil, nil
 }
+
+// ValidateVendorConfig makes sure exactly one vendor block is configured and that
+// it matches `vendor` when the latter is set explicitly.
+func ValidateVendorConfig(vendor string, configured []string) error {
+	if len(configured) == 0 {
+		return fmt.Errorf("one of %q or %q must be configured", S3, GCS)
+	}
+
+	if len(configured) > 1 {
+		return fmt.Errorf("only one of %q or %q can be configured, got: %v", S3, GCS, configured)
+	}
+
+	if vendor != "" && vendor != configured[0] {
+		return fmt.Errorf("vendor %q requires the %q block to be configured, got %q", vendor, VendorFields[vendor], configured[0])
+	}
+
+	return nil
+}
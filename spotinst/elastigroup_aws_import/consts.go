@@ -0,0 +1,30 @@
+package elastigroup_aws_import
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	AutoScalingGroupName commons.FieldName = "auto_scaling_group_name"
+	Region               commons.FieldName = "region"
+
+	// - Overrides ----------------------------
+	Name                  commons.FieldName = "name"
+	MaxSize               commons.FieldName = "max_size"
+	MinSize               commons.FieldName = "min_size"
+	DesiredCapacity       commons.FieldName = "desired_capacity"
+	SpotPercentage        commons.FieldName = "spot_percentage"
+	Orientation           commons.FieldName = "orientation"
+	FallbackToOnDemand    commons.FieldName = "fallback_to_ondemand"
+	InstanceTypesOnDemand commons.FieldName = "instance_types_ondemand"
+	InstanceTypesSpot     commons.FieldName = "instance_types_spot"
+	// ----------------------------------------
+
+	// - Imported -----------------------------
+	ImageID         commons.FieldName = "image_id"
+	SubnetIDs       commons.FieldName = "subnet_ids"
+	TargetGroupArns commons.FieldName = "target_group_arns"
+	// ----------------------------------------
+
+	ScaleDownASG           commons.FieldName = "scale_down_asg"
+	WaitForCapacityTimeout commons.FieldName = "wait_for_capacity_timeout"
+	ASGScaledDown          commons.FieldName = "asg_scaled_down"
+)
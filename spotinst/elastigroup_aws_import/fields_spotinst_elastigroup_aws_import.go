@@ -0,0 +1,495 @@
+package elastigroup_aws_import
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[AutoScalingGroupName] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		AutoScalingGroupName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Region] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		Region,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Name] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		Name,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if err := resourceData.Set(string(Name), spotinst.StringValue(elastigroup.Name)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Name), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(Name)); ok {
+				elastigroup.SetName(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			elastigroup.SetName(spotinst.String(resourceData.Get(string(Name)).(string)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MaxSize] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		MaxSize,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *int = nil
+			if elastigroup.Capacity != nil && elastigroup.Capacity.Maximum != nil {
+				value = elastigroup.Capacity.Maximum
+			}
+			if err := resourceData.Set(string(MaxSize), spotinst.IntValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MaxSize), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOkExists(string(MaxSize)); ok {
+				elastigroup.Capacity.SetMaximum(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			elastigroup.Capacity.SetMaximum(spotinst.Int(resourceData.Get(string(MaxSize)).(int)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MinSize] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		MinSize,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *int = nil
+			if elastigroup.Capacity != nil && elastigroup.Capacity.Minimum != nil {
+				value = elastigroup.Capacity.Minimum
+			}
+			if err := resourceData.Set(string(MinSize), spotinst.IntValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MinSize), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOkExists(string(MinSize)); ok {
+				elastigroup.Capacity.SetMinimum(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			elastigroup.Capacity.SetMinimum(spotinst.Int(resourceData.Get(string(MinSize)).(int)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[DesiredCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		DesiredCapacity,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *int = nil
+			if elastigroup.Capacity != nil && elastigroup.Capacity.Target != nil {
+				value = elastigroup.Capacity.Target
+			}
+			if err := resourceData.Set(string(DesiredCapacity), spotinst.IntValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(DesiredCapacity), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOkExists(string(DesiredCapacity)); ok {
+				elastigroup.Capacity.SetTarget(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			elastigroup.Capacity.SetTarget(spotinst.Int(resourceData.Get(string(DesiredCapacity)).(int)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[SpotPercentage] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		SpotPercentage,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *float64 = nil
+			if elastigroup.Strategy != nil && elastigroup.Strategy.Risk != nil {
+				value = elastigroup.Strategy.Risk
+			}
+			if err := resourceData.Set(string(SpotPercentage), int(spotinst.Float64Value(value))); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(SpotPercentage), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOkExists(string(SpotPercentage)); ok {
+				elastigroup.Strategy.SetRisk(spotinst.Float64(float64(v.(int))))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			elastigroup.Strategy.SetRisk(spotinst.Float64(float64(resourceData.Get(string(SpotPercentage)).(int))))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Orientation] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		Orientation,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"balanced", "costOriented", "availabilityOriented", "equalAzDistribution",
+			}, false),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *string = nil
+			if elastigroup.Strategy != nil && elastigroup.Strategy.AvailabilityVsCost != nil {
+				value = elastigroup.Strategy.AvailabilityVsCost
+			}
+			if err := resourceData.Set(string(Orientation), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Orientation), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(Orientation)); ok {
+				elastigroup.Strategy.SetAvailabilityVsCost(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(Orientation)); ok {
+				elastigroup.Strategy.SetAvailabilityVsCost(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[FallbackToOnDemand] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		FallbackToOnDemand,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *bool = nil
+			if elastigroup.Strategy != nil && elastigroup.Strategy.FallbackToOnDemand != nil {
+				value = elastigroup.Strategy.FallbackToOnDemand
+			}
+			if err := resourceData.Set(string(FallbackToOnDemand), spotinst.BoolValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(FallbackToOnDemand), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOkExists(string(FallbackToOnDemand)); ok {
+				elastigroup.Strategy.SetFallbackToOnDemand(spotinst.Bool(v.(bool)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			elastigroup.Strategy.SetFallbackToOnDemand(spotinst.Bool(resourceData.Get(string(FallbackToOnDemand)).(bool)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[InstanceTypesOnDemand] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		InstanceTypesOnDemand,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *string = nil
+			if elastigroup.Compute != nil && elastigroup.Compute.InstanceTypes != nil {
+				value = elastigroup.Compute.InstanceTypes.OnDemand
+			}
+			if err := resourceData.Set(string(InstanceTypesOnDemand), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstanceTypesOnDemand), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(InstanceTypesOnDemand)); ok {
+				elastigroup.Compute.InstanceTypes.SetOnDemand(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(InstanceTypesOnDemand)); ok {
+				elastigroup.Compute.InstanceTypes.SetOnDemand(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[InstanceTypesSpot] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		InstanceTypesSpot,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var result []string
+			if elastigroup.Compute != nil && elastigroup.Compute.InstanceTypes != nil {
+				result = append(result, elastigroup.Compute.InstanceTypes.Spot...)
+			}
+			if err := resourceData.Set(string(InstanceTypesSpot), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstanceTypesSpot), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(InstanceTypesSpot)); ok {
+				elastigroup.Compute.InstanceTypes.SetSpot(expandInstanceTypes(v.([]interface{})))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			if v, ok := resourceData.GetOk(string(InstanceTypesSpot)); ok {
+				elastigroup.Compute.InstanceTypes.SetSpot(expandInstanceTypes(v.([]interface{})))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ImageID] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		ImageID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var value *string = nil
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil {
+				value = elastigroup.Compute.LaunchSpecification.ImageID
+			}
+			if err := resourceData.Set(string(ImageID), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ImageID), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[SubnetIDs] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		SubnetIDs,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var result []string
+			if elastigroup.Compute != nil {
+				result = append(result, elastigroup.Compute.SubnetIDs...)
+			}
+			if err := resourceData.Set(string(SubnetIDs), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(SubnetIDs), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[TargetGroupArns] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		TargetGroupArns,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
+			elastigroup := egWrapper.GetElastigroup()
+			var result []string
+			if elastigroup.Compute != nil && elastigroup.Compute.LaunchSpecification != nil &&
+				elastigroup.Compute.LaunchSpecification.LoadBalancersConfig != nil {
+				for _, balancer := range elastigroup.Compute.LaunchSpecification.LoadBalancersConfig.LoadBalancers {
+					if spotinst.StringValue(balancer.Type) == string(elastigroup_aws.BalancerTypeTargetGroup) {
+						result = append(result, spotinst.StringValue(balancer.Arn))
+					}
+				}
+			}
+			if err := resourceData.Set(string(TargetGroupArns), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TargetGroupArns), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[ScaleDownASG] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		ScaleDownASG,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForCapacityTimeout] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		WaitForCapacityTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      600,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[ASGScaledDown] = commons.NewGenericField(
+		commons.ElastigroupAWSImport,
+		ASGScaledDown,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+}
+
+func expandInstanceTypes(list []interface{}) []string {
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if instanceType, ok := v.(string); ok && instanceType != "" {
+			result = append(result, instanceType)
+		}
+	}
+	return result
+}
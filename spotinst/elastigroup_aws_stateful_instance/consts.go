@@ -0,0 +1,30 @@
+package elastigroup_aws_stateful_instance
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	GroupID             commons.FieldName = "group_id"
+	StatefulInstanceID  commons.FieldName = "stateful_instance_id"
+	State               commons.FieldName = "state"
+	RecycleTriggers     commons.FieldName = "recycle_triggers"
+	WaitForStateTimeout commons.FieldName = "wait_for_state_timeout"
+	Status              commons.FieldName = "status"
+	InstanceID          commons.FieldName = "instance_id"
+	PrivateIP           commons.FieldName = "private_ip"
+	ImageID             commons.FieldName = "image_id"
+)
+
+// Desired states of a stateful instance.
+const (
+	StateActive      = "active"
+	StatePaused      = "paused"
+	StateDeallocated = "deallocated"
+)
+
+// States of a stateful instance as reported by the API.
+const (
+	StatusActive      = "ACTIVE"
+	StatusPaused      = "PAUSED"
+	StatusDeallocated = "DEALLOCATED"
+	StatusError       = "ERROR"
+)
@@ -0,0 +1,194 @@
+package elastigroup_aws_stateful_instance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[GroupID] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		GroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			statefulInstance := resourceObject.(*commons.ElastigroupAWSStatefulInstance)
+			statefulInstance.GroupID = spotinst.String(resourceData.Get(string(GroupID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[StatefulInstanceID] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		StatefulInstanceID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			statefulInstance := resourceObject.(*commons.ElastigroupAWSStatefulInstance)
+			statefulInstance.StatefulInstanceID = spotinst.String(resourceData.Get(string(StatefulInstanceID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[State] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		State,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      StateActive,
+			ValidateFunc: validation.StringInSlice([]string{StateActive, StatePaused, StateDeallocated}, false),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			statefulInstance := resourceObject.(*commons.ElastigroupAWSStatefulInstance)
+			if statefulInstance.StatefulInstance == nil {
+				return nil
+			}
+
+			// Only settled states are reflected, so that an instance that is
+			// still transitioning does not show up as a drift.
+			var state string
+			switch strings.ToUpper(spotinst.StringValue(statefulInstance.StatefulInstance.State)) {
+			case StatusActive:
+				state = StateActive
+			case StatusPaused:
+				state = StatePaused
+			case StatusDeallocated:
+				state = StateDeallocated
+			default:
+				return nil
+			}
+
+			if err := resourceData.Set(string(State), state); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(State), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[RecycleTriggers] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		RecycleTriggers,
+		&schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForStateTimeout] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		WaitForStateTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      900,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Status] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		Status,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			statefulInstance := resourceObject.(*commons.ElastigroupAWSStatefulInstance)
+			var value *string
+			if statefulInstance.StatefulInstance != nil {
+				value = statefulInstance.StatefulInstance.State
+			}
+			if err := resourceData.Set(string(Status), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Status), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[InstanceID] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		InstanceID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			statefulInstance := resourceObject.(*commons.ElastigroupAWSStatefulInstance)
+			var value *string
+			if statefulInstance.StatefulInstance != nil {
+				value = statefulInstance.StatefulInstance.InstanceID
+			}
+			if err := resourceData.Set(string(InstanceID), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstanceID), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[PrivateIP] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		PrivateIP,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			statefulInstance := resourceObject.(*commons.ElastigroupAWSStatefulInstance)
+			var value *string
+			if statefulInstance.StatefulInstance != nil {
+				value = statefulInstance.StatefulInstance.PrivateIP
+			}
+			if err := resourceData.Set(string(PrivateIP), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(PrivateIP), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[ImageID] = commons.NewGenericField(
+		commons.ElastigroupAWSStatefulInstance,
+		ImageID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			statefulInstance := resourceObject.(*commons.ElastigroupAWSStatefulInstance)
+			var value *string
+			if statefulInstance.StatefulInstance != nil {
+				value = statefulInstance.StatefulInstance.ImageID
+			}
+			if err := resourceData.Set(string(ImageID), spotinst.StringValue(value)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ImageID), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+}
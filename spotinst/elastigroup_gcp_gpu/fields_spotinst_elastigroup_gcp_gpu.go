@@ -15,17 +15,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Type:     schema.TypeSet,
 			Optional: true,
 			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					string(Count): {
-						Type:     schema.TypeInt,
-						Required: true,
-					},
-
-					string(Type): {
-						Type:     schema.TypeString,
-						Required: true,
-					},
-				},
+				Schema: GPUSchema(),
 			},
 			//
 		},
@@ -36,7 +26,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupGCPWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(GPU)); ok {
-				if gpu, err := expandGPU(v); err != nil {
+				if gpu, err := ExpandGPU(v); err != nil {
 					return err
 				} else {
 					elastigroup.Compute.SetGPU(gpu)
@@ -49,7 +39,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var result *gcp.GPU = nil
 			if v, ok := resourceData.GetOk(string(GPU)); ok {
-				if gpu, err := expandGPU(v); err != nil {
+				if gpu, err := ExpandGPU(v); err != nil {
 					return err
 				} else {
 					result = gpu
@@ -63,11 +53,38 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
 }
 
-func expandGPU(data interface{}) (*gcp.GPU, error) {
-	list := data.(*schema.Set).List()
+// GPUSchema returns the schema of a GPU block, which is shared by Elastigroups
+// and Ocean GKE launch specs.
+func GPUSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		string(Count): {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+
+		string(Type): {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+// ExpandGPU expands a GPU block, held either in a set or in a list.
+func ExpandGPU(data interface{}) (*gcp.GPU, error) {
+	var list []interface{}
+	switch v := data.(type) {
+	case *schema.Set:
+		list = v.List()
+	case []interface{}:
+		list = v
+	}
+
 	gpu := &gcp.GPU{}
 	for _, item := range list {
-		m := item.(map[string]interface{})
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
 
 		if v, ok := m[string(Count)].(int); ok && v >= 0 {
 			gpu.SetCount(spotinst.Int(v))
@@ -78,3 +95,13 @@ func expandGPU(data interface{}) (*gcp.GPU, error) {
 	}
 	return gpu, nil
 }
+
+// FlattenGPU flattens a GPU into a GPU block.
+func FlattenGPU(gpu *gcp.GPU) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			string(Count): spotinst.IntValue(gpu.Count),
+			string(Type):  spotinst.StringValue(gpu.Type),
+		},
+	}
+}
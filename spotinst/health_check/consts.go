@@ -16,6 +16,16 @@ const (
 	Unhealthy  commons.FieldName = "unhealthy"
 	Healthy    commons.FieldName = "healthy"
 
+	ExpectedStatusCodes commons.FieldName = "expected_status_codes"
+	GRPCService         commons.FieldName = "grpc_service"
+
+	Status           commons.FieldName = "status"
+	HealthyCount     commons.FieldName = "healthy_count"
+	UnhealthyCount   commons.FieldName = "unhealthy_count"
+	InstanceStatuses commons.FieldName = "instance_statuses"
+	InstanceID       commons.FieldName = "instance_id"
+	Reason           commons.FieldName = "reason"
+
 	// Deprecated: EndPoint is obsolete, exists for backward compatibility only,
 	// and should not be used. Please use Endpoint instead.
 	EndPoint commons.FieldName = "end_point"
@@ -24,3 +34,16 @@ const (
 	// and should not be used. Please use Timeout instead.
 	TimeOut commons.FieldName = "time_out"
 )
+
+const (
+	ProtocolHTTP  = "http"
+	ProtocolHTTPS = "https"
+	ProtocolTCP   = "tcp"
+	ProtocolGRPC  = "grpc"
+)
+
+const (
+	StatusHealthy   = "HEALTHY"
+	StatusUnhealthy = "UNHEALTHY"
+	StatusUnknown   = "UNKNOWN"
+)
@@ -2,8 +2,10 @@ package health_check
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -170,8 +172,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(Port): {
-						Type:     schema.TypeInt,
-						Required: true,
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
 					},
 
 					string(Endpoint): {
@@ -208,6 +211,20 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeInt,
 						Required: true,
 					},
+
+					string(ExpectedStatusCodes): {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeInt,
+							ValidateFunc: validation.IntBetween(100, 599),
+						},
+					},
+
+					string(GRPCService): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
 				},
 			},
 		},
@@ -243,6 +260,63 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[Status] = commons.NewGenericField(
+		commons.HealthCheck,
+		Status,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[HealthyCount] = commons.NewGenericField(
+		commons.HealthCheck,
+		HealthyCount,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[UnhealthyCount] = commons.NewGenericField(
+		commons.HealthCheck,
+		UnhealthyCount,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[InstanceStatuses] = commons.NewGenericField(
+		commons.HealthCheck,
+		InstanceStatuses,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(InstanceID): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(Status): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(Reason): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
 }
 
 func expandCheck(data interface{}) (*healthcheck.Check, error) {
@@ -253,8 +327,9 @@ func expandCheck(data interface{}) (*healthcheck.Check, error) {
 	}
 	m := list[0].(map[string]interface{})
 
-	if v, ok := m[string(Protocol)].(string); ok && v != "" {
-		check.SetProtocol(spotinst.String(v))
+	protocol := strings.ToLower(m[string(Protocol)].(string))
+	if protocol != "" {
+		check.SetProtocol(spotinst.String(protocol))
 	}
 
 	if v, ok := m[string(Port)].(int); ok && v > 0 {
@@ -271,6 +346,22 @@ func expandCheck(data interface{}) (*healthcheck.Check, error) {
 		check.SetEndpoint(nil)
 	}
 
+	if v, ok := m[string(ExpectedStatusCodes)].(*schema.Set); ok && v.Len() > 0 {
+		codes := make([]int, 0, v.Len())
+		for _, code := range v.List() {
+			codes = append(codes, code.(int))
+		}
+		check.SetExpectedStatusCodes(codes)
+	} else {
+		check.SetExpectedStatusCodes(nil)
+	}
+
+	if v, ok := m[string(GRPCService)].(string); ok && v != "" {
+		check.SetGRPCService(spotinst.String(v))
+	} else {
+		check.SetGRPCService(nil)
+	}
+
 	if v, ok := m[string(Interval)].(int); ok && v > 0 {
 		check.SetInterval(spotinst.Int(v))
 	} else {
@@ -20,6 +20,14 @@ const (
 	ExposeClusterID   commons.FieldName = "expose_cluster_id"
 	OutputClusterID   commons.FieldName = "output_cluster_id"
 
+	WaitForReady          commons.FieldName = "wait_for_ready"
+	WaitForReadyTimeout   commons.FieldName = "wait_for_ready_timeout"
+	ClusterState          commons.FieldName = "cluster_state"
+	MasterPublicDNS       commons.FieldName = "master_public_dns"
+	MasterInstanceGroupID commons.FieldName = "master_instance_group_id"
+	CoreInstanceGroupID   commons.FieldName = "core_instance_group_id"
+	TaskInstanceGroupID   commons.FieldName = "task_instance_group_id"
+
 	ConfigurationsFile   commons.FieldName = "configurations_file"
 	BootstrapActionsFile commons.FieldName = "bootstrap_actions_file"
 	StepsFile            commons.FieldName = "steps_file"
@@ -45,3 +53,14 @@ const (
 	InstanceType     commons.FieldName = "instance_type"
 	WeightedCapacity commons.FieldName = "weighted_capacity"
 )
+
+// EMR cluster states, see https://docs.aws.amazon.com/emr/latest/APIReference/API_ClusterStatus.html
+const (
+	ClusterStateStarting             = "STARTING"
+	ClusterStateBootstrapping        = "BOOTSTRAPPING"
+	ClusterStateRunning              = "RUNNING"
+	ClusterStateWaiting              = "WAITING"
+	ClusterStateTerminating          = "TERMINATING"
+	ClusterStateTerminated           = "TERMINATED"
+	ClusterStateTerminatedWithErrors = "TERMINATED_WITH_ERRORS"
+)
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/mrscaler"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -211,6 +212,79 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[WaitForReady] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		WaitForReady,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForReadyTimeout] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		WaitForReadyTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      3600,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[ClusterState] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		ClusterState,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[MasterPublicDNS] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		MasterPublicDNS,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[MasterInstanceGroupID] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		MasterInstanceGroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[CoreInstanceGroupID] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		CoreInstanceGroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[TaskInstanceGroupID] = commons.NewGenericField(
+		commons.MRScalerAWS,
+		TaskInstanceGroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
 	fieldsMap[AvailabilityZones] = commons.NewGenericField(
 		commons.MRScalerAWS,
 		AvailabilityZones,
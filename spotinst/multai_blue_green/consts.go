@@ -0,0 +1,26 @@
+package multai_blue_green
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	RoutingRuleID        commons.FieldName = "routing_rule_id"
+	BlueTargetSetID      commons.FieldName = "blue_target_set_id"
+	GreenTargetSetID     commons.FieldName = "green_target_set_id"
+	GreenWeight          commons.FieldName = "green_weight"
+	StepSize             commons.FieldName = "step_size"
+	StepInterval         commons.FieldName = "step_interval"
+	MinHealthyPercentage commons.FieldName = "min_healthy_percentage"
+	RollbackOnFailure    commons.FieldName = "rollback_on_failure"
+	Status               commons.FieldName = "status"
+	Message              commons.FieldName = "message"
+)
+
+// TotalWeight is split between the blue and green target sets.
+const TotalWeight = 100
+
+const (
+	StatusInProgress = "IN_PROGRESS"
+	StatusCompleted  = "COMPLETED"
+	StatusRolledBack = "ROLLED_BACK"
+	StatusFailed     = "FAILED"
+)
@@ -0,0 +1,221 @@
+package multai_blue_green
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[RoutingRuleID] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		RoutingRuleID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.RoutingRuleID = spotinst.String(resourceData.Get(string(RoutingRuleID)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.RoutingRuleID = spotinst.String(resourceData.Get(string(RoutingRuleID)).(string))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[BlueTargetSetID] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		BlueTargetSetID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.BlueTargetSetID = spotinst.String(resourceData.Get(string(BlueTargetSetID)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.BlueTargetSetID = spotinst.String(resourceData.Get(string(BlueTargetSetID)).(string))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[GreenTargetSetID] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		GreenTargetSetID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.GreenTargetSetID = spotinst.String(resourceData.Get(string(GreenTargetSetID)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.GreenTargetSetID = spotinst.String(resourceData.Get(string(GreenTargetSetID)).(string))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[GreenWeight] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		GreenWeight,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, TotalWeight),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			if blueGreen.GreenWeight != nil {
+				if err := resourceData.Set(string(GreenWeight), spotinst.IntValue(blueGreen.GreenWeight)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(GreenWeight), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.GreenWeight = spotinst.Int(resourceData.Get(string(GreenWeight)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.GreenWeight = spotinst.Int(resourceData.Get(string(GreenWeight)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[StepSize] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		StepSize,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, TotalWeight),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.StepSize = spotinst.Int(resourceData.Get(string(StepSize)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.StepSize = spotinst.Int(resourceData.Get(string(StepSize)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[StepInterval] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		StepInterval,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      60,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.StepInterval = spotinst.Int(resourceData.Get(string(StepInterval)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.StepInterval = spotinst.Int(resourceData.Get(string(StepInterval)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MinHealthyPercentage] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		MinHealthyPercentage,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntBetween(0, 100),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.MinHealthyPercentage = spotinst.Int(resourceData.Get(string(MinHealthyPercentage)).(int))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.MinHealthyPercentage = spotinst.Int(resourceData.Get(string(MinHealthyPercentage)).(int))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[RollbackOnFailure] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		RollbackOnFailure,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.RollbackOnFailure = spotinst.Bool(resourceData.Get(string(RollbackOnFailure)).(bool))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			blueGreen := resourceObject.(*commons.MultaiBlueGreen)
+			blueGreen.RollbackOnFailure = spotinst.Bool(resourceData.Get(string(RollbackOnFailure)).(bool))
+			return nil
+		},
+		nil,
+	)
+
+	// Rollout progress is written by the resource itself after every step.
+	fieldsMap[Status] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		Status,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Message] = commons.NewGenericField(
+		commons.MultaiBlueGreen,
+		Message,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+}
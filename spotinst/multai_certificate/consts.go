@@ -0,0 +1,13 @@
+package multai_certificate
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	Name                    commons.FieldName = "name"
+	CertificateBody         commons.FieldName = "certificate_body"
+	CertificateChain        commons.FieldName = "certificate_chain"
+	PrivateKey              commons.FieldName = "private_key"
+	NotBefore               commons.FieldName = "not_before"
+	NotAfter                commons.FieldName = "not_after"
+	SubjectAlternativeNames commons.FieldName = "subject_alternative_names"
+)
@@ -0,0 +1,214 @@
+package multai_certificate
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[Name] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		Name,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			var value *string = nil
+			if certificate.Name != nil {
+				value = certificate.Name
+			}
+			if err := resourceData.Set(string(Name), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Name), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.SetName(spotinst.String(resourceData.Get(string(Name)).(string)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.SetName(spotinst.String(resourceData.Get(string(Name)).(string)))
+			return nil
+		},
+		nil,
+	)
+
+	// The API stores the certificate and its chain as a single PEM block, so
+	// the chain is sent along with the body.
+	fieldsMap[CertificateBody] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		CertificateBody,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateCertificate,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.SetCertPEMBlock(spotinst.String(certificatePEMBlock(resourceData)))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[CertificateChain] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		CertificateChain,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateCertificate,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[PrivateKey] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		PrivateKey,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validatePrivateKey,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			certificateWrapper := resourceObject.(*commons.MultaiCertificateWrapper)
+			certificate := certificateWrapper.GetMultaiCertificate()
+			certificate.SetKeyPEMBlock(spotinst.String(resourceData.Get(string(PrivateKey)).(string)))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[NotBefore] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		NotBefore,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			cert, err := readCertificate(resourceObject)
+			if err != nil || cert == nil {
+				return err
+			}
+			if err := resourceData.Set(string(NotBefore), cert.NotBefore.UTC().Format(time.RFC3339)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(NotBefore), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[NotAfter] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		NotAfter,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			cert, err := readCertificate(resourceObject)
+			if err != nil || cert == nil {
+				return err
+			}
+			if err := resourceData.Set(string(NotAfter), cert.NotAfter.UTC().Format(time.RFC3339)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(NotAfter), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[SubjectAlternativeNames] = commons.NewGenericField(
+		commons.MultaiCertificate,
+		SubjectAlternativeNames,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			cert, err := readCertificate(resourceObject)
+			if err != nil || cert == nil {
+				return err
+			}
+			names := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses))
+			names = append(names, cert.DNSNames...)
+			for _, ip := range cert.IPAddresses {
+				names = append(names, ip.String())
+			}
+			if err := resourceData.Set(string(SubjectAlternativeNames), names); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(SubjectAlternativeNames), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+}
+
+func certificatePEMBlock(resourceData *schema.ResourceData) string {
+	block := strings.TrimSpace(resourceData.Get(string(CertificateBody)).(string))
+	if v, ok := resourceData.GetOk(string(CertificateChain)); ok {
+		block += "\n" + strings.TrimSpace(v.(string))
+	}
+	return block + "\n"
+}
+
+// readCertificate parses the certificate returned by the API, so the metadata
+// describes the uploaded certificate rather than the configuration. It returns
+// nil when the API response holds no certificate.
+func readCertificate(resourceObject interface{}) (*x509.Certificate, error) {
+	certificate := resourceObject.(*commons.MultaiCertificateWrapper).GetMultaiCertificate()
+	if certificate.CertPEMBlock == nil {
+		return nil, nil
+	}
+	return ParseCertificate(spotinst.StringValue(certificate.CertPEMBlock))
+}
+
+// ParseCertificate parses the first certificate of a PEM encoded block.
+func ParseCertificate(value string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func validateCertificate(v interface{}, k string) (warns []string, errs []error) {
+	if _, err := ParseCertificate(v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%q: invalid certificate: %s", k, err))
+	}
+	return
+}
+
+func validatePrivateKey(v interface{}, k string) (warns []string, errs []error) {
+	block, _ := pem.Decode([]byte(v.(string)))
+	if block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+		errs = append(errs, fmt.Errorf("%q: no PEM encoded private key found", k))
+	}
+	return
+}
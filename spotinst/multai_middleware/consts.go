@@ -0,0 +1,59 @@
+package multai_middleware
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	BalancerID    commons.FieldName = "balancer_id"
+	Type          commons.FieldName = "type"
+	Priority      commons.FieldName = "priority"
+	HeaderRewrite commons.FieldName = "header_rewrite"
+	Redirect      commons.FieldName = "redirect"
+	RateLimit     commons.FieldName = "rate_limit"
+	BasicAuth     commons.FieldName = "basic_auth"
+	Tags          commons.FieldName = "tags"
+
+	TagKey   commons.FieldName = "key"
+	TagValue commons.FieldName = "value"
+)
+
+const (
+	RequestHeaders        commons.FieldName = "request_headers"
+	ResponseHeaders       commons.FieldName = "response_headers"
+	RemoveRequestHeaders  commons.FieldName = "remove_request_headers"
+	RemoveResponseHeaders commons.FieldName = "remove_response_headers"
+)
+
+const (
+	RedirectScheme     commons.FieldName = "scheme"
+	RedirectHost       commons.FieldName = "host"
+	RedirectPort       commons.FieldName = "port"
+	RedirectPath       commons.FieldName = "path"
+	RedirectStatusCode commons.FieldName = "status_code"
+)
+
+const (
+	RateLimitAverage commons.FieldName = "average"
+	RateLimitBurst   commons.FieldName = "burst"
+	RateLimitPeriod  commons.FieldName = "period"
+	RateLimitSource  commons.FieldName = "source"
+)
+
+const (
+	BasicAuthUsers        commons.FieldName = "users"
+	BasicAuthRealm        commons.FieldName = "realm"
+	BasicAuthRemoveHeader commons.FieldName = "remove_header"
+)
+
+const (
+	TypeHeaderRewrite = "HEADER_REWRITE"
+	TypeRedirect      = "REDIRECT"
+	TypeRateLimit     = "RATE_LIMIT"
+	TypeBasicAuth     = "BASIC_AUTH"
+)
+
+var typeBlockNames = []string{
+	string(HeaderRewrite),
+	string(Redirect),
+	string(RateLimit),
+	string(BasicAuth),
+}
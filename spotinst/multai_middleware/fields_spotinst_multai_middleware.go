@@ -0,0 +1,621 @@
+package multai_middleware
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[BalancerID] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		BalancerID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value *string = nil
+			if middleware.BalancerID != nil {
+				value = middleware.BalancerID
+			}
+			if err := resourceData.Set(string(BalancerID), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(BalancerID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			middleware.SetBalancerId(spotinst.String(resourceData.Get(string(BalancerID)).(string)))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Type] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		Type,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value *string = nil
+			if middleware.Type != nil {
+				value = middleware.Type
+			}
+			if err := resourceData.Set(string(Type), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Type), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[Priority] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		Priority,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value *int = nil
+			if middleware.Priority != nil {
+				value = middleware.Priority
+			}
+			if err := resourceData.Set(string(Priority), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Priority), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(Priority)); ok {
+				middleware.SetPriority(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(Priority)); ok {
+				middleware.SetPriority(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[HeaderRewrite] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		HeaderRewrite,
+		&schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: typeBlockNames,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(RequestHeaders): {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+
+					string(ResponseHeaders): {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+
+					string(RemoveRequestHeaders): {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+
+					string(RemoveResponseHeaders): {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value []interface{} = nil
+			spec := &headerRewriteSpec{}
+			if ok, err := readSpec(middleware, TypeHeaderRewrite, spec); err != nil {
+				return err
+			} else if ok {
+				value = flattenHeaderRewrite(spec)
+			}
+			if err := resourceData.Set(string(HeaderRewrite), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(HeaderRewrite), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(HeaderRewrite)); ok {
+				return setSpec(middleware, TypeHeaderRewrite, expandHeaderRewrite(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(HeaderRewrite)); ok {
+				return setSpec(middleware, TypeHeaderRewrite, expandHeaderRewrite(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Redirect] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		Redirect,
+		&schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: typeBlockNames,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(RedirectScheme): {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+					},
+
+					string(RedirectHost): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					string(RedirectPort): {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+
+					string(RedirectPath): {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringMatch(pathRegex, "must start with \"/\""),
+					},
+
+					string(RedirectStatusCode): {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      302,
+						ValidateFunc: validation.IntInSlice([]int{301, 302, 307, 308}),
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value []interface{} = nil
+			spec := &redirectSpec{}
+			if ok, err := readSpec(middleware, TypeRedirect, spec); err != nil {
+				return err
+			} else if ok {
+				value = flattenRedirect(spec)
+			}
+			if err := resourceData.Set(string(Redirect), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Redirect), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(Redirect)); ok {
+				return setSpec(middleware, TypeRedirect, expandRedirect(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(Redirect)); ok {
+				return setSpec(middleware, TypeRedirect, expandRedirect(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[RateLimit] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		RateLimit,
+		&schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: typeBlockNames,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(RateLimitAverage): {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					string(RateLimitBurst): {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					string(RateLimitPeriod): {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					string(RateLimitSource): {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "client_ip",
+						ValidateFunc: validation.StringInSlice([]string{"client_ip", "request_host"}, false),
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var value []interface{} = nil
+			spec := &rateLimitSpec{}
+			if ok, err := readSpec(middleware, TypeRateLimit, spec); err != nil {
+				return err
+			} else if ok {
+				value = flattenRateLimit(spec)
+			}
+			if err := resourceData.Set(string(RateLimit), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(RateLimit), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(RateLimit)); ok {
+				return setSpec(middleware, TypeRateLimit, expandRateLimit(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(RateLimit)); ok {
+				return setSpec(middleware, TypeRateLimit, expandRateLimit(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[BasicAuth] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		BasicAuth,
+		&schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: typeBlockNames,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(BasicAuthUsers): {
+						Type:      schema.TypeSet,
+						Required:  true,
+						Sensitive: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validateBasicAuthUser,
+						},
+					},
+
+					string(BasicAuthRealm): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+
+					string(BasicAuthRemoveHeader): {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+		// The API does not return the users, so the configured block is kept.
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if spotinst.StringValue(middleware.Type) != TypeBasicAuth {
+				if err := resourceData.Set(string(BasicAuth), nil); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(BasicAuth), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(BasicAuth)); ok {
+				return setSpec(middleware, TypeBasicAuth, expandBasicAuth(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if v, ok := resourceData.GetOk(string(BasicAuth)); ok {
+				return setSpec(middleware, TypeBasicAuth, expandBasicAuth(v))
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Tags] = commons.NewGenericField(
+		commons.MultaiMiddleware,
+		Tags,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(TagKey): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(TagValue): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			if value, ok := resourceData.GetOk(string(Tags)); ok {
+				if tags, err := expandTags(value); err != nil {
+					return err
+				} else {
+					middleware.SetTags(tags)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			middlewareWrapper := resourceObject.(*commons.MultaiMiddlewareWrapper)
+			middleware := middlewareWrapper.GetMultaiMiddleware()
+			var tagsToAdd []*multai.Tag = nil
+			if value, ok := resourceData.GetOk(string(Tags)); ok {
+				if tags, err := expandTags(value); err != nil {
+					return err
+				} else {
+					tagsToAdd = tags
+				}
+			}
+			middleware.SetTags(tagsToAdd)
+			return nil
+		},
+		nil,
+	)
+}
+
+var pathRegex = regexp.MustCompile(`^/`)
+
+func expandStringMap(data interface{}) map[string]string {
+	m := data.(map[string]interface{})
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v.(string)
+	}
+	return result
+}
+
+func expandStringSet(data interface{}) []string {
+	list := data.(*schema.Set).List()
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		result = append(result, v.(string))
+	}
+	return result
+}
+
+func expandHeaderRewrite(data interface{}) *headerRewriteSpec {
+	headerRewrite := &headerRewriteSpec{}
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return headerRewrite
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(RequestHeaders)]; ok {
+		headerRewrite.RequestHeaders = expandStringMap(v)
+	}
+	if v, ok := m[string(ResponseHeaders)]; ok {
+		headerRewrite.ResponseHeaders = expandStringMap(v)
+	}
+	if v, ok := m[string(RemoveRequestHeaders)]; ok {
+		headerRewrite.RemoveRequestHeaders = expandStringSet(v)
+	}
+	if v, ok := m[string(RemoveResponseHeaders)]; ok {
+		headerRewrite.RemoveResponseHeaders = expandStringSet(v)
+	}
+	return headerRewrite
+}
+
+func flattenHeaderRewrite(headerRewrite *headerRewriteSpec) []interface{} {
+	m := make(map[string]interface{})
+	m[string(RequestHeaders)] = headerRewrite.RequestHeaders
+	m[string(ResponseHeaders)] = headerRewrite.ResponseHeaders
+	m[string(RemoveRequestHeaders)] = headerRewrite.RemoveRequestHeaders
+	m[string(RemoveResponseHeaders)] = headerRewrite.RemoveResponseHeaders
+	return []interface{}{m}
+}
+
+func expandRedirect(data interface{}) *redirectSpec {
+	redirect := &redirectSpec{}
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return redirect
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(RedirectScheme)].(string); ok && v != "" {
+		redirect.Scheme = spotinst.String(v)
+	}
+	if v, ok := m[string(RedirectHost)].(string); ok && v != "" {
+		redirect.Host = spotinst.String(v)
+	}
+	if v, ok := m[string(RedirectPort)].(int); ok && v > 0 {
+		redirect.Port = spotinst.Int(v)
+	}
+	if v, ok := m[string(RedirectPath)].(string); ok && v != "" {
+		redirect.Path = spotinst.String(v)
+	}
+	if v, ok := m[string(RedirectStatusCode)].(int); ok && v > 0 {
+		redirect.StatusCode = spotinst.Int(v)
+	}
+	return redirect
+}
+
+func flattenRedirect(redirect *redirectSpec) []interface{} {
+	m := make(map[string]interface{})
+	m[string(RedirectScheme)] = spotinst.StringValue(redirect.Scheme)
+	m[string(RedirectHost)] = spotinst.StringValue(redirect.Host)
+	m[string(RedirectPort)] = spotinst.IntValue(redirect.Port)
+	m[string(RedirectPath)] = spotinst.StringValue(redirect.Path)
+	m[string(RedirectStatusCode)] = spotinst.IntValue(redirect.StatusCode)
+	return []interface{}{m}
+}
+
+func expandRateLimit(data interface{}) *rateLimitSpec {
+	rateLimit := &rateLimitSpec{}
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return rateLimit
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(RateLimitAverage)].(int); ok {
+		rateLimit.Average = spotinst.Int(v)
+	}
+	if v, ok := m[string(RateLimitBurst)].(int); ok {
+		rateLimit.Burst = spotinst.Int(v)
+	}
+	if v, ok := m[string(RateLimitPeriod)].(int); ok {
+		rateLimit.Period = spotinst.Int(v)
+	}
+	if v, ok := m[string(RateLimitSource)].(string); ok && v != "" {
+		rateLimit.Source = spotinst.String(strings.ToUpper(v))
+	}
+	return rateLimit
+}
+
+func flattenRateLimit(rateLimit *rateLimitSpec) []interface{} {
+	m := make(map[string]interface{})
+	m[string(RateLimitAverage)] = spotinst.IntValue(rateLimit.Average)
+	m[string(RateLimitBurst)] = spotinst.IntValue(rateLimit.Burst)
+	m[string(RateLimitPeriod)] = spotinst.IntValue(rateLimit.Period)
+	m[string(RateLimitSource)] = strings.ToLower(spotinst.StringValue(rateLimit.Source))
+	return []interface{}{m}
+}
+
+func expandBasicAuth(data interface{}) *basicAuthSpec {
+	basicAuth := &basicAuthSpec{}
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return basicAuth
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(BasicAuthUsers)]; ok {
+		basicAuth.Users = expandStringSet(v)
+	}
+	if v, ok := m[string(BasicAuthRealm)].(string); ok && v != "" {
+		basicAuth.Realm = spotinst.String(v)
+	}
+	if v, ok := m[string(BasicAuthRemoveHeader)].(bool); ok {
+		basicAuth.RemoveHeader = spotinst.Bool(v)
+	}
+	return basicAuth
+}
+
+// validateBasicAuthUser validates that a user is in the htpasswd format
+// `name:hashed-password`. The value is not echoed since it is sensitive.
+func validateBasicAuthUser(v interface{}, k string) (warns []string, errs []error) {
+	parts := strings.SplitN(v.(string), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		errs = append(errs, fmt.Errorf("%q: expected users in the format name:hashed-password", k))
+		return
+	}
+	if !strings.HasPrefix(parts[1], "$apr1$") && !strings.HasPrefix(parts[1], "$2y$") &&
+		!strings.HasPrefix(parts[1], "{SHA}") {
+		errs = append(errs, fmt.Errorf("%q: the password of user %q must be hashed with MD5 (apr1), bcrypt or SHA1", k, parts[0]))
+	}
+	return
+}
+
+func expandTags(data interface{}) ([]*multai.Tag, error) {
+	list := data.(*schema.Set).List()
+	tags := make([]*multai.Tag, 0, len(list))
+	for _, v := range list {
+		attr, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := attr[string(TagKey)]; !ok {
+			return nil, errors.New("invalid tag attributes: key missing")
+		}
+
+		if _, ok := attr[string(TagValue)]; !ok {
+			return nil, errors.New("invalid tag attributes: value missing")
+		}
+		tag := &multai.Tag{
+			Key:   spotinst.String(attr[string(TagKey)].(string)),
+			Value: spotinst.String(attr[string(TagValue)].(string)),
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
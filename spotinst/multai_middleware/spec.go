@@ -0,0 +1,63 @@
+package multai_middleware
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// The API takes the settings of a middleware as a free-form spec whose layout
+// depends on the middleware type. These types describe the supported layouts.
+
+type headerRewriteSpec struct {
+	RequestHeaders        map[string]string `json:"requestHeaders,omitempty"`
+	ResponseHeaders       map[string]string `json:"responseHeaders,omitempty"`
+	RemoveRequestHeaders  []string          `json:"removeRequestHeaders,omitempty"`
+	RemoveResponseHeaders []string          `json:"removeResponseHeaders,omitempty"`
+}
+
+type redirectSpec struct {
+	Scheme     *string `json:"scheme,omitempty"`
+	Host       *string `json:"host,omitempty"`
+	Port       *int    `json:"port,omitempty"`
+	Path       *string `json:"path,omitempty"`
+	StatusCode *int    `json:"statusCode,omitempty"`
+}
+
+type rateLimitSpec struct {
+	Average *int    `json:"average,omitempty"`
+	Burst   *int    `json:"burst,omitempty"`
+	Period  *int    `json:"period,omitempty"`
+	Source  *string `json:"source,omitempty"`
+}
+
+type basicAuthSpec struct {
+	Users        []string `json:"users,omitempty"`
+	Realm        *string  `json:"realm,omitempty"`
+	RemoveHeader *bool    `json:"removeHeader,omitempty"`
+}
+
+// setSpec sets the type of the middleware and encodes spec as its settings.
+func setSpec(middleware *multai.Middleware, middlewareType string, spec interface{}) error {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s middleware spec: %s", middlewareType, err)
+	}
+	middleware.SetType(spotinst.String(middlewareType))
+	middleware.Spec = raw
+	return nil
+}
+
+// readSpec decodes the settings of the middleware into spec, and reports
+// whether the middleware is of the given type.
+func readSpec(middleware *multai.Middleware, middlewareType string, spec interface{}) (bool, error) {
+	if spotinst.StringValue(middleware.Type) != middlewareType || len(middleware.Spec) == 0 {
+		return false, nil
+	}
+	if err := json.Unmarshal(middleware.Spec, spec); err != nil {
+		return false, fmt.Errorf("failed to decode %s middleware spec: %s", middlewareType, err)
+	}
+	return true, nil
+}
@@ -84,8 +84,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.MultaiRoutingRule,
 		Route,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: ValidateRoute,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			routingWrapper := resourceObject.(*commons.MultaiRoutingRuleWrapper)
@@ -0,0 +1,231 @@
+package multai_routing_rule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// RouteMatchers holds the matchers that can be used in a route expression,
+// and the minimum number of arguments each of them takes.
+var RouteMatchers = map[string]int{
+	"Host":          1,
+	"HostRegexp":    1,
+	"Path":          1,
+	"PathPrefix":    1,
+	"PathRegexp":    1,
+	"Method":        1,
+	"Headers":       2,
+	"HeadersRegexp": 2,
+	"Query":         1,
+}
+
+// ValidateRoute checks the syntax of a route expression, such as
+// Host(`example.com`) && (PathPrefix(`/api`) || !Method(`GET`)). Syntax
+// errors fail validation. The API may support matchers this parser does not
+// know, so unknown matchers are only reported as warnings.
+func ValidateRoute(v interface{}, k string) (warns []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	unknown, err := ParseRoute(value)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q: invalid route %q: %s", k, value, err))
+		return
+	}
+	if len(unknown) > 0 {
+		matchers := make([]string, 0, len(RouteMatchers))
+		for matcher := range RouteMatchers {
+			matchers = append(matchers, matcher)
+		}
+		sort.Strings(matchers)
+		warns = append(warns, fmt.Sprintf("%q: route %q uses unknown matchers %s, expected one of: %s",
+			k, value, strings.Join(unknown, ", "), strings.Join(matchers, ", ")))
+	}
+	return
+}
+
+// ParseRoute returns an error describing the first syntax error of a route
+// expression, if any, and the matchers it uses that are not in RouteMatchers.
+func ParseRoute(route string) ([]string, error) {
+	tokens, err := tokenizeRoute(route)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("route is empty")
+	}
+
+	p := &routeParser{tokens: tokens}
+	if err := p.parseOr(); err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q", p.tokens[p.pos].value)
+	}
+	return p.unknown, nil
+}
+
+type routeTokenKind int
+
+const (
+	routeTokenIdent routeTokenKind = iota
+	routeTokenString
+	routeTokenOperator
+)
+
+type routeToken struct {
+	kind  routeTokenKind
+	value string
+}
+
+func tokenizeRoute(route string) ([]routeToken, error) {
+	var tokens []routeToken
+	for i := 0; i < len(route); {
+		c := rune(route[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case strings.HasPrefix(route[i:], "&&"), strings.HasPrefix(route[i:], "||"):
+			tokens = append(tokens, routeToken{routeTokenOperator, route[i : i+2]})
+			i += 2
+		case strings.ContainsRune("!(),", c):
+			tokens = append(tokens, routeToken{routeTokenOperator, string(c)})
+			i++
+		case c == '`' || c == '"':
+			end := strings.IndexRune(route[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+			tokens = append(tokens, routeToken{routeTokenString, route[i+1 : i+1+end]})
+			i += end + 2
+		case unicode.IsLetter(c):
+			start := i
+			for i < len(route) && unicode.IsLetter(rune(route[i])) {
+				i++
+			}
+			tokens = append(tokens, routeToken{routeTokenIdent, route[start:i]})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, i)
+		}
+	}
+	return tokens, nil
+}
+
+type routeParser struct {
+	tokens  []routeToken
+	pos     int
+	unknown []string
+}
+
+func (p *routeParser) peek(value string) bool {
+	return p.pos < len(p.tokens) && p.tokens[p.pos].kind == routeTokenOperator && p.tokens[p.pos].value == value
+}
+
+func (p *routeParser) expect(value string) error {
+	if !p.peek(value) {
+		if p.pos < len(p.tokens) {
+			return fmt.Errorf("expected %q, got %q", value, p.tokens[p.pos].value)
+		}
+		return fmt.Errorf("expected %q at end of route", value)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *routeParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.peek("||") {
+		p.pos++
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *routeParser) parseAnd() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for p.peek("&&") {
+		p.pos++
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *routeParser) parseUnary() error {
+	if p.peek("!") {
+		p.pos++
+		return p.parseUnary()
+	}
+	if p.peek("(") {
+		p.pos++
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		return p.expect(")")
+	}
+	return p.parseMatcher()
+}
+
+func (p *routeParser) parseMatcher() error {
+	if p.pos >= len(p.tokens) {
+		return fmt.Errorf("expected a matcher at end of route")
+	}
+	token := p.tokens[p.pos]
+	if token.kind != routeTokenIdent {
+		return fmt.Errorf("expected a matcher, got %q", token.value)
+	}
+
+	minArgs, known := RouteMatchers[token.value]
+	if !known {
+		p.unknown = append(p.unknown, token.value)
+	}
+	p.pos++
+
+	if err := p.expect("("); err != nil {
+		return err
+	}
+
+	var args []string
+	for {
+		if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != routeTokenString {
+			return fmt.Errorf("%s() arguments must be quoted with backticks or double quotes", token.value)
+		}
+		args = append(args, p.tokens[p.pos].value)
+		p.pos++
+		if !p.peek(",") {
+			break
+		}
+		p.pos++
+	}
+
+	if err := p.expect(")"); err != nil {
+		return err
+	}
+
+	if !known {
+		return nil
+	}
+	if len(args) < minArgs {
+		return fmt.Errorf("%s() takes at least %d arguments, got %d", token.value, minArgs, len(args))
+	}
+	if token.value == "Path" || token.value == "PathPrefix" {
+		for _, arg := range args {
+			if !strings.HasPrefix(arg, "/") {
+				return fmt.Errorf("%s() paths must start with \"/\", got %q", token.value, arg)
+			}
+		}
+	}
+	return nil
+}
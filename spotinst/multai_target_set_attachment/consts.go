@@ -0,0 +1,21 @@
+package multai_target_set_attachment
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	BalancerID              commons.FieldName = "balancer_id"
+	TargetSetID             commons.FieldName = "target_set_id"
+	ElastigroupID           commons.FieldName = "elastigroup_id"
+	OceanLaunchSpecID       commons.FieldName = "ocean_launch_spec_id"
+	DrainingTimeout         commons.FieldName = "draining_timeout"
+	Targets                 commons.FieldName = "targets"
+	UnregisteredInstanceIDs commons.FieldName = "unregistered_instance_ids"
+)
+
+const (
+	TargetID     commons.FieldName = "target_id"
+	TargetName   commons.FieldName = "name"
+	TargetHost   commons.FieldName = "host"
+	TargetPort   commons.FieldName = "port"
+	TargetStatus commons.FieldName = "status"
+)
@@ -0,0 +1,187 @@
+package multai_target_set_attachment
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[BalancerID] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		BalancerID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.MultaiTargetSetAttachment)
+			attachment.BalancerID = spotinst.String(resourceData.Get(string(BalancerID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[TargetSetID] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		TargetSetID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.MultaiTargetSetAttachment)
+			attachment.TargetSetID = spotinst.String(resourceData.Get(string(TargetSetID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[ElastigroupID] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		ElastigroupID,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{string(ElastigroupID), string(OceanLaunchSpecID)},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.MultaiTargetSetAttachment)
+			if v, ok := resourceData.GetOk(string(ElastigroupID)); ok {
+				attachment.ElastigroupID = spotinst.String(v.(string))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[OceanLaunchSpecID] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		OceanLaunchSpecID,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{string(ElastigroupID), string(OceanLaunchSpecID)},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.MultaiTargetSetAttachment)
+			if v, ok := resourceData.GetOk(string(OceanLaunchSpecID)); ok {
+				attachment.OceanLaunchSpecID = spotinst.String(v.(string))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[DrainingTimeout] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		DrainingTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Targets] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		Targets,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(TargetID): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(TargetName): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(TargetHost): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(TargetPort): {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+
+					string(TargetStatus): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.MultaiTargetSetAttachment)
+			if err := resourceData.Set(string(Targets), flattenTargets(attachment.Targets)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Targets), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[UnregisteredInstanceIDs] = commons.NewGenericField(
+		commons.MultaiTargetSetAttachment,
+		UnregisteredInstanceIDs,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.MultaiTargetSetAttachment)
+			if err := resourceData.Set(string(UnregisteredInstanceIDs), attachment.UnregisteredInstanceIDs); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UnregisteredInstanceIDs), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
+
+func flattenTargets(targets []*multai.Target) []interface{} {
+	result := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		m := make(map[string]interface{})
+		m[string(TargetID)] = spotinst.StringValue(target.ID)
+		m[string(TargetName)] = spotinst.StringValue(target.Name)
+		m[string(TargetHost)] = spotinst.StringValue(target.Host)
+		m[string(TargetPort)] = spotinst.IntValue(target.Port)
+		if target.Status != nil {
+			m[string(TargetStatus)] = spotinst.StringValue(target.Status.Status)
+		}
+		result = append(result, m)
+	}
+	return result
+}
@@ -0,0 +1,58 @@
+package notification_policy
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	ResourceType         commons.FieldName = "resource_type"
+	TagSelector          commons.FieldName = "tag_selector"
+	EventTypes           commons.FieldName = "event_types"
+	EmailAddresses       commons.FieldName = "email_addresses"
+	SlackWebhookURLs     commons.FieldName = "slack_webhook_urls"
+	SNSTopicARNs         commons.FieldName = "sns_topic_arns"
+	WebhookURLs          commons.FieldName = "webhook_urls"
+	WebhookSigningSecret commons.FieldName = "webhook_signing_secret"
+	Format               commons.FieldName = "format"
+	Subscriptions        commons.FieldName = "subscriptions"
+)
+
+const (
+	TagSelectorKey   commons.FieldName = "key"
+	TagSelectorValue commons.FieldName = "value"
+)
+
+const (
+	SubscriptionID         commons.FieldName = "id"
+	SubscriptionResourceID commons.FieldName = "resource_id"
+	SubscriptionEventType  commons.FieldName = "event_type"
+	SubscriptionProtocol   commons.FieldName = "protocol"
+	SubscriptionEndpoint   commons.FieldName = "endpoint"
+)
+
+const (
+	ResourceTypeElastigroupAWS = "elastigroup_aws"
+	ResourceTypeOceanAWS       = "ocean_aws"
+)
+
+// ResourceTypes holds the resource types a notification policy can target.
+var ResourceTypes = []string{ResourceTypeElastigroupAWS, ResourceTypeOceanAWS}
+
+const (
+	ProtocolEmail = "email"
+	ProtocolSNS   = "aws-sns"
+	ProtocolWeb   = "web"
+)
+
+// EndpointProtocols maps every endpoint field to the protocol its endpoints
+// are notified with.
+var EndpointProtocols = map[commons.FieldName]string{
+	EmailAddresses:   ProtocolEmail,
+	SlackWebhookURLs: ProtocolWeb,
+	SNSTopicARNs:     ProtocolSNS,
+	WebhookURLs:      ProtocolWeb,
+}
+
+// slackFormat is the payload sent to Slack incoming webhooks, which expect a
+// `text` field.
+var slackFormat = map[string]interface{}{
+	"text": "%event%: %resource-name% (%resource-id%) %instance-id% %reason%",
+}
@@ -0,0 +1,293 @@
+package notification_policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/subscription"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[ResourceType] = commons.NewGenericField(
+		commons.NotificationPolicy,
+		ResourceType,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(ResourceTypes, false),
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			policy.ResourceType = spotinst.String(resourceData.Get(string(ResourceType)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			policy.ResourceType = spotinst.String(resourceData.Get(string(ResourceType)).(string))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[TagSelector] = commons.NewGenericField(
+		commons.NotificationPolicy,
+		TagSelector,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(TagSelectorKey): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(TagSelectorValue): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			if v, ok := resourceData.GetOk(string(TagSelector)); ok {
+				policy.TagSelectors = ExpandTagSelectors(v)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			if v, ok := resourceData.GetOk(string(TagSelector)); ok {
+				policy.TagSelectors = ExpandTagSelectors(v)
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[EventTypes] = commons.NewGenericField(
+		commons.NotificationPolicy,
+		EventTypes,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: subscription.ValidateEventType,
+				StateFunc: func(v interface{}) string {
+					return strings.ToUpper(v.(string))
+				},
+			},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			policy.EventTypes = expandUpperStrings(resourceData.Get(string(EventTypes)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			policy.EventTypes = expandUpperStrings(resourceData.Get(string(EventTypes)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[EmailAddresses] = newEndpointsField(EmailAddresses, nil)
+	fieldsMap[SlackWebhookURLs] = newEndpointsField(SlackWebhookURLs, slackFormat)
+	fieldsMap[SNSTopicARNs] = newEndpointsField(SNSTopicARNs, nil)
+	fieldsMap[WebhookURLs] = newEndpointsField(WebhookURLs, nil)
+
+	fieldsMap[WebhookSigningSecret] = commons.NewGenericField(
+		commons.NotificationPolicy,
+		WebhookSigningSecret,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{string(WebhookURLs)},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Format] = commons.NewGenericField(
+		commons.NotificationPolicy,
+		Format,
+		&schema.Schema{
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ValidateFunc: subscription.ValidateFormat,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			if v, ok := resourceData.GetOk(string(Format)); ok {
+				policy.Format = v.(map[string]interface{})
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			if v, ok := resourceData.GetOk(string(Format)); ok {
+				policy.Format = v.(map[string]interface{})
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Subscriptions] = commons.NewGenericField(
+		commons.NotificationPolicy,
+		Subscriptions,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(SubscriptionID): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(SubscriptionResourceID): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(SubscriptionEventType): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(SubscriptionProtocol): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(SubscriptionEndpoint): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			policy := resourceObject.(*commons.NotificationPolicy)
+			if err := resourceData.Set(string(Subscriptions), flattenSubscriptions(policy.Subscriptions)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Subscriptions), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
+
+// newEndpointsField returns a field holding a set of endpoints that are
+// notified using the field's protocol and, when set, a fixed payload format.
+func newEndpointsField(fieldName commons.FieldName, format map[string]interface{}) *commons.GenericField {
+	protocol := EndpointProtocols[fieldName]
+	onCreateOrUpdate := func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+		policy := resourceObject.(*commons.NotificationPolicy)
+		var secret *string
+		if fieldName == WebhookURLs {
+			if v, ok := resourceData.GetOk(string(WebhookSigningSecret)); ok {
+				secret = spotinst.String(v.(string))
+			}
+		}
+		if v, ok := resourceData.GetOk(string(fieldName)); ok {
+			for _, endpoint := range v.(*schema.Set).List() {
+				policy.Endpoints = append(policy.Endpoints, &commons.NotificationPolicyEndpoint{
+					Protocol:      spotinst.String(protocol),
+					Endpoint:      spotinst.String(endpoint.(string)),
+					SigningSecret: secret,
+					Format:        format,
+				})
+			}
+		}
+		return nil
+	}
+
+	return commons.NewGenericField(
+		commons.NotificationPolicy,
+		fieldName,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil,
+		onCreateOrUpdate,
+		onCreateOrUpdate,
+		nil,
+	)
+}
+
+// ExpandTagSelectors expands the tag_selector set.
+func ExpandTagSelectors(data interface{}) []*commons.NotificationPolicyTagSelector {
+	list := data.(*schema.Set).List()
+	selectors := make([]*commons.NotificationPolicyTagSelector, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		selector := &commons.NotificationPolicyTagSelector{
+			Key: spotinst.String(m[string(TagSelectorKey)].(string)),
+		}
+		if v, ok := m[string(TagSelectorValue)].(string); ok && v != "" {
+			selector.Value = spotinst.String(v)
+		}
+		selectors = append(selectors, selector)
+	}
+	return selectors
+}
+
+func expandUpperStrings(data interface{}) []string {
+	list := data.(*schema.Set).List()
+	out := make([]string, 0, len(list))
+	for _, v := range list {
+		out = append(out, strings.ToUpper(v.(string)))
+	}
+	return out
+}
+
+func flattenSubscriptions(subscriptions []*commons.NotificationPolicySubscription) []interface{} {
+	result := make([]interface{}, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		result = append(result, map[string]interface{}{
+			string(SubscriptionID):         spotinst.StringValue(sub.ID),
+			string(SubscriptionResourceID): spotinst.StringValue(sub.ResourceID),
+			string(SubscriptionEventType):  spotinst.StringValue(sub.EventType),
+			string(SubscriptionProtocol):   spotinst.StringValue(sub.Protocol),
+			string(SubscriptionEndpoint):   spotinst.StringValue(sub.Endpoint),
+		})
+	}
+	return result
+}
+
+// ExpandSubscriptions returns the subscriptions held by a `subscriptions` value.
+func ExpandSubscriptions(data interface{}) []*commons.NotificationPolicySubscription {
+	list, _ := data.([]interface{})
+	out := make([]*commons.NotificationPolicySubscription, 0, len(list))
+	for _, item := range list {
+		m := item.(map[string]interface{})
+		out = append(out, &commons.NotificationPolicySubscription{
+			ID:         spotinst.String(m[string(SubscriptionID)].(string)),
+			ResourceID: spotinst.String(m[string(SubscriptionResourceID)].(string)),
+			EventType:  spotinst.String(m[string(SubscriptionEventType)].(string)),
+			Protocol:   spotinst.String(m[string(SubscriptionProtocol)].(string)),
+			Endpoint:   spotinst.String(m[string(SubscriptionEndpoint)].(string)),
+		})
+	}
+	return out
+}
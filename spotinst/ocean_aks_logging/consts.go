@@ -0,0 +1,12 @@
+package ocean_aks_logging
+
+import (
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+const (
+	Logging commons.FieldName = "logging"
+	Export  commons.FieldName = "export"
+	S3      commons.FieldName = "s3"
+	Id      commons.FieldName = "id"
+)
@@ -32,4 +32,8 @@ const (
 	LaunchSpecIDs             commons.FieldName = "launch_spec_ids"
 	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
 	RespectPDB                commons.FieldName = "respect_pdb"
+
+	ImportFrom           commons.FieldName = "import_from"
+	EKSClusterName       commons.FieldName = "eks_cluster_name"
+	AutoScalingGroupName commons.FieldName = "auto_scaling_group_name"
 )
@@ -240,9 +240,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeList,
 			Elem:     &schema.Schema{Type: schema.TypeString},
-			Required: true,
+			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ImportFrom), string(SubnetIDs)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value []string = nil
@@ -399,6 +402,41 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil, nil, nil, nil,
 	)
+
+	fieldsMap[ImportFrom] = commons.NewGenericField(
+		commons.OceanAWS,
+		ImportFrom,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(EKSClusterName): {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+						ExactlyOneOf: []string{
+							string(ImportFrom) + ".0." + string(EKSClusterName),
+							string(ImportFrom) + ".0." + string(AutoScalingGroupName),
+						},
+					},
+
+					string(AutoScalingGroupName): {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+						ExactlyOneOf: []string{
+							string(ImportFrom) + ".0." + string(EKSClusterName),
+							string(ImportFrom) + ".0." + string(AutoScalingGroupName),
+						},
+					},
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
 }
 
 func expandSubnetIDs(data interface{}) ([]string, error) {
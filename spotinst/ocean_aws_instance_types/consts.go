@@ -29,3 +29,7 @@ const (
 	RootDeviceTypes       commons.FieldName = "root_device_types"
 	VirtualizationTypes   commons.FieldName = "virtualization_types"
 )
+
+const (
+	FilteredInstanceTypes commons.FieldName = "filtered_instance_types"
+)
@@ -122,112 +122,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			MaxItems:      1,
 			ConflictsWith: []string{string(Blacklist), string(Whitelist)},
 			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-
-					string(Architectures): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(Categories): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(DiskTypes): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(ExcludeFamilies): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(ExcludeMetal): {
-						Type:     schema.TypeBool,
-						Optional: true,
-						Default:  false,
-					},
-
-					string(Hypervisor): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(IncludeFamilies): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(IsEnaSupported): {
-						Type:     schema.TypeBool,
-						Optional: true,
-					},
-
-					string(MaxGpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-					},
-
-					string(MaxMemoryGiB): {
-						Type:     schema.TypeFloat,
-						Optional: true,
-					},
-
-					string(MaxNetworkPerformance): {
-						Type:     schema.TypeInt,
-						Optional: true,
-					},
-
-					string(MaxVcpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-					},
-
-					string(MinEnis): {
-						Type:     schema.TypeInt,
-						Optional: true,
-					},
-
-					string(MinGpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-					},
-
-					string(MinMemoryGiB): {
-						Type:     schema.TypeFloat,
-						Optional: true,
-					},
-
-					string(MinNetworkPerformance): {
-						Type:     schema.TypeInt,
-						Optional: true,
-					},
-
-					string(MinVcpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-					},
-
-					string(RootDeviceTypes): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(VirtualizationTypes): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-				},
+				Schema: FiltersSchema(),
 			},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -237,7 +132,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
 			if cluster != nil && cluster.Compute != nil && cluster.Compute.InstanceTypes != nil &&
 				cluster.Compute.InstanceTypes.Filters != nil {
-				result = flattenFilters(cluster.Compute.InstanceTypes.Filters)
+				result = FlattenFilters(cluster.Compute.InstanceTypes.Filters)
 			}
 			if len(result) > 0 {
 				if err := resourceData.Set(string(Filters), result); err != nil {
@@ -251,7 +146,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			if v, ok := resourceData.GetOk(string(Filters)); ok {
-				if filters, err := expandFilters(v, false); err != nil {
+				if filters, err := ExpandFilters(v, false); err != nil {
 					return err
 				} else {
 					cluster.Compute.InstanceTypes.SetFilters(filters)
@@ -266,7 +161,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var value *aws.Filters = nil
 
 			if v, ok := resourceData.GetOk(string(Filters)); ok {
-				if filters, err := expandFilters(v, true); err != nil {
+				if filters, err := ExpandFilters(v, true); err != nil {
 					return err
 				} else {
 					value = filters
@@ -280,9 +175,132 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[FilteredInstanceTypes] = commons.NewGenericField(
+		commons.OceanAWSInstanceTypes,
+		FilteredInstanceTypes,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	)
+}
+
+// FiltersSchema returns the schema of the instance types filters block, which
+// is shared by Ocean clusters and launch specs.
+func FiltersSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		string(Architectures): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		string(Categories): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		string(DiskTypes): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		string(ExcludeFamilies): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		string(ExcludeMetal): {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		string(Hypervisor): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		string(IncludeFamilies): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		string(IsEnaSupported): {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+
+		string(MaxGpu): {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		string(MaxMemoryGiB): {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+
+		string(MaxNetworkPerformance): {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		string(MaxVcpu): {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		string(MinEnis): {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		string(MinGpu): {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		string(MinMemoryGiB): {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+
+		string(MinNetworkPerformance): {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		string(MinVcpu): {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+
+		string(RootDeviceTypes): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		string(VirtualizationTypes): {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
 }
 
-func expandFilters(data interface{}, nullify bool) (*aws.Filters, error) {
+// ExpandFilters expands an instance types filters block. With nullify set,
+// empty lists are sent as null so that they are cleared on update.
+func ExpandFilters(data interface{}, nullify bool) (*aws.Filters, error) {
 	filters := &aws.Filters{}
 	list := data.([]interface{})
 	if list == nil || list[0] == nil {
@@ -473,7 +491,8 @@ func expandInstanceTypeFiltersList(data interface{}) ([]string, error) {
 	return result, nil
 }
 
-func flattenFilters(filters *aws.Filters) []interface{} {
+// FlattenFilters flattens instance types filters into a filters block.
+func FlattenFilters(filters *aws.Filters) []interface{} {
 	var out []interface{}
 
 	if filters != nil {
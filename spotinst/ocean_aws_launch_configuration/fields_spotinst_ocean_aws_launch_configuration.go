@@ -9,6 +9,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -21,6 +22,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_aws.ImportFrom), string(ImageID)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value *string = nil
@@ -101,6 +105,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_aws.ImportFrom), string(IAMInstanceProfile)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value = ""
@@ -160,6 +167,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_aws.ImportFrom), string(KeyName)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value *string = nil
@@ -198,10 +208,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		SecurityGroups,
 		&schema.Schema{
 			Type:     schema.TypeList,
-			Required: true,
+			Optional: true,
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_aws.ImportFrom), string(SecurityGroups)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value []string = nil
@@ -258,6 +271,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			StateFunc: Base64StateFunc,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_aws.ImportFrom), string(UserData)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
 			cluster := clusterWrapper.GetCluster()
 			var value = ""
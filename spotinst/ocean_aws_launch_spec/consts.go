@@ -117,3 +117,8 @@ const (
 const (
 	TimeWindows commons.FieldName = "time_windows"
 )
+
+const (
+	InstanceTypesFilters  commons.FieldName = "instance_types_filters"
+	FilteredInstanceTypes commons.FieldName = "filtered_instance_types"
+)
@@ -11,6 +11,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_instance_types"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -1023,6 +1024,70 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[InstanceTypesFilters] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpec,
+		InstanceTypesFilters,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{string(InstanceTypes)},
+			Elem: &schema.Resource{
+				Schema: ocean_aws_instance_types.FiltersSchema(),
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.LaunchSpecWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			var result []interface{} = nil
+			if launchSpec.InstanceTypesFilters != nil {
+				result = flattenInstanceTypesFilters(launchSpec.InstanceTypesFilters)
+			}
+			if err := resourceData.Set(string(InstanceTypesFilters), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(InstanceTypesFilters), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.LaunchSpecWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			if v, ok := resourceData.GetOk(string(InstanceTypesFilters)); ok {
+				if filters, err := expandInstanceTypesFilters(v, false); err != nil {
+					return err
+				} else {
+					launchSpec.SetInstanceTypesFilters(filters)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.LaunchSpecWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			var value *aws.InstanceTypesFilters = nil
+			if v, ok := resourceData.GetOk(string(InstanceTypesFilters)); ok {
+				if filters, err := expandInstanceTypesFilters(v, true); err != nil {
+					return err
+				} else {
+					value = filters
+				}
+			}
+			launchSpec.SetInstanceTypesFilters(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[FilteredInstanceTypes] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpec,
+		FilteredInstanceTypes,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	)
+
 	fieldsMap[PreferredSpotTypes] = commons.NewGenericField(
 		commons.OceanAWSLaunchSpec,
 		PreferredSpotTypes,
@@ -2231,3 +2296,99 @@ func expandTimeWindows(data interface{}) ([]string, error) {
 
 	return result, nil
 }
+
+// expandInstanceTypesFilters expands the instance types filters block with the
+// expander shared with Ocean clusters.
+func expandInstanceTypesFilters(data interface{}, nullify bool) (*aws.InstanceTypesFilters, error) {
+	f, err := ocean_aws_instance_types.ExpandFilters(data, nullify)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &aws.InstanceTypesFilters{}
+	if f.Architectures != nil || nullify {
+		filters.SetArchitectures(f.Architectures)
+	}
+	if f.Categories != nil || nullify {
+		filters.SetCategories(f.Categories)
+	}
+	if f.DiskTypes != nil || nullify {
+		filters.SetDiskTypes(f.DiskTypes)
+	}
+	if f.ExcludeFamilies != nil || nullify {
+		filters.SetExcludeFamilies(f.ExcludeFamilies)
+	}
+	if f.Hypervisor != nil || nullify {
+		filters.SetHypervisor(f.Hypervisor)
+	}
+	if f.IncludeFamilies != nil || nullify {
+		filters.SetIncludeFamilies(f.IncludeFamilies)
+	}
+	if f.RootDeviceTypes != nil || nullify {
+		filters.SetRootDeviceTypes(f.RootDeviceTypes)
+	}
+	if f.VirtualizationTypes != nil || nullify {
+		filters.SetVirtualizationTypes(f.VirtualizationTypes)
+	}
+	if f.ExcludeMetal != nil {
+		filters.SetExcludeMetal(f.ExcludeMetal)
+	}
+	if f.IsEnaSupported != nil {
+		filters.SetIsEnaSupported(f.IsEnaSupported)
+	}
+	if f.MaxGpu != nil {
+		filters.SetMaxGpu(f.MaxGpu)
+	}
+	if f.MaxMemoryGiB != nil {
+		filters.SetMaxMemoryGiB(f.MaxMemoryGiB)
+	}
+	if f.MaxNetworkPerformance != nil {
+		filters.SetMaxNetworkPerformance(f.MaxNetworkPerformance)
+	}
+	if f.MaxVcpu != nil {
+		filters.SetMaxVcpu(f.MaxVcpu)
+	}
+	if f.MinEnis != nil {
+		filters.SetMinEnis(f.MinEnis)
+	}
+	if f.MinGpu != nil {
+		filters.SetMinGpu(f.MinGpu)
+	}
+	if f.MinMemoryGiB != nil {
+		filters.SetMinMemoryGiB(f.MinMemoryGiB)
+	}
+	if f.MinNetworkPerformance != nil {
+		filters.SetMinNetworkPerformance(f.MinNetworkPerformance)
+	}
+	if f.MinVcpu != nil {
+		filters.SetMinVcpu(f.MinVcpu)
+	}
+
+	return filters, nil
+}
+
+// flattenInstanceTypesFilters flattens the instance types filters with the
+// flattener shared with Ocean clusters.
+func flattenInstanceTypesFilters(filters *aws.InstanceTypesFilters) []interface{} {
+	return ocean_aws_instance_types.FlattenFilters(&aws.Filters{
+		Architectures:         filters.Architectures,
+		Categories:            filters.Categories,
+		DiskTypes:             filters.DiskTypes,
+		ExcludeFamilies:       filters.ExcludeFamilies,
+		ExcludeMetal:          filters.ExcludeMetal,
+		Hypervisor:            filters.Hypervisor,
+		IncludeFamilies:       filters.IncludeFamilies,
+		IsEnaSupported:        filters.IsEnaSupported,
+		MaxGpu:                filters.MaxGpu,
+		MaxMemoryGiB:          filters.MaxMemoryGiB,
+		MaxNetworkPerformance: filters.MaxNetworkPerformance,
+		MaxVcpu:               filters.MaxVcpu,
+		MinEnis:               filters.MinEnis,
+		MinGpu:                filters.MinGpu,
+		MinMemoryGiB:          filters.MinMemoryGiB,
+		MinNetworkPerformance: filters.MinNetworkPerformance,
+		MinVcpu:               filters.MinVcpu,
+		RootDeviceTypes:       filters.RootDeviceTypes,
+		VirtualizationTypes:   filters.VirtualizationTypes,
+	})
+}
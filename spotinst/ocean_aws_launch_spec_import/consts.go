@@ -0,0 +1,28 @@
+package ocean_aws_launch_spec_import
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+type LabelField string
+type TaintField string
+
+const (
+	LabelKey   LabelField = "key"
+	LabelValue LabelField = "value"
+)
+
+const (
+	TaintKey   TaintField = "key"
+	TaintValue TaintField = "value"
+	Effect     TaintField = "effect"
+)
+
+const (
+	OceanID        commons.FieldName = "ocean_id"
+	EKSClusterName commons.FieldName = "eks_cluster_name"
+	NodeGroupName  commons.FieldName = "node_group_name"
+	Name           commons.FieldName = "name"
+	ImageID        commons.FieldName = "image_id"
+	SecurityGroups commons.FieldName = "security_groups"
+	Labels         commons.FieldName = "labels"
+	Taints         commons.FieldName = "taints"
+)
@@ -0,0 +1,239 @@
+package ocean_aws_launch_spec_import
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[OceanID] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpecImport,
+		OceanID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.AWSLaunchSpecImportWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			var value *string = nil
+			if launchSpec.OceanID != nil {
+				value = launchSpec.OceanID
+			}
+			if err := resourceData.Set(string(OceanID), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(OceanID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.AWSLaunchSpecImportWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			launchSpec.SetOceanId(spotinst.String(resourceData.Get(string(OceanID)).(string)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			err := fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern),
+				string(OceanID))
+			return err
+		},
+		nil,
+	)
+
+	fieldsMap[EKSClusterName] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpecImport,
+		EKSClusterName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[NodeGroupName] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpecImport,
+		NodeGroupName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[Name] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpecImport,
+		Name,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.AWSLaunchSpecImportWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			var value *string = nil
+			if launchSpec.Name != nil {
+				value = launchSpec.Name
+			}
+			if err := resourceData.Set(string(Name), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Name), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.AWSLaunchSpecImportWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			if v, ok := resourceData.GetOk(string(Name)); ok && v.(string) != "" {
+				launchSpec.SetName(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[ImageID] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpecImport,
+		ImageID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.AWSLaunchSpecImportWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			var value *string = nil
+			if launchSpec.ImageID != nil {
+				value = launchSpec.ImageID
+			}
+			if err := resourceData.Set(string(ImageID), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ImageID), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[SecurityGroups] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpecImport,
+		SecurityGroups,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.AWSLaunchSpecImportWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			var value []string = nil
+			if launchSpec.SecurityGroupIDs != nil {
+				value = launchSpec.SecurityGroupIDs
+			}
+			if err := resourceData.Set(string(SecurityGroups), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(SecurityGroups), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[Labels] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpecImport,
+		Labels,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(LabelKey): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(LabelValue): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.AWSLaunchSpecImportWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			if err := resourceData.Set(string(Labels), flattenLabels(launchSpec.Labels)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Labels), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+
+	fieldsMap[Taints] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpecImport,
+		Taints,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(TaintKey): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(TaintValue): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					string(Effect): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.AWSLaunchSpecImportWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			if err := resourceData.Set(string(Taints), flattenTaints(launchSpec.Taints)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Taints), err)
+			}
+			return nil
+		},
+		nil, nil, nil,
+	)
+}
+
+func flattenLabels(labels []*aws.Label) []interface{} {
+	result := make([]interface{}, 0, len(labels))
+	for _, label := range labels {
+		m := make(map[string]interface{})
+		m[string(LabelKey)] = spotinst.StringValue(label.Key)
+		m[string(LabelValue)] = spotinst.StringValue(label.Value)
+
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenTaints(taints []*aws.Taint) []interface{} {
+	result := make([]interface{}, 0, len(taints))
+	for _, taint := range taints {
+		m := make(map[string]interface{})
+		m[string(TaintKey)] = spotinst.StringValue(taint.Key)
+		m[string(TaintValue)] = spotinst.StringValue(taint.Value)
+		m[string(Effect)] = spotinst.StringValue(taint.Effect)
+
+		result = append(result, m)
+	}
+	return result
+}
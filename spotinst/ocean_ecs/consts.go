@@ -20,6 +20,7 @@ const (
 	BatchSizePercentage       commons.FieldName = "batch_size_percentage"
 	BatchMinHealthyPercentage commons.FieldName = "batch_min_healthy_percentage"
 	Tags                      commons.FieldName = "tags"
+	ImportFromCluster         commons.FieldName = "import_from_cluster"
 	TagKey                    TagField          = "key"
 	TagValue                  TagField          = "value"
 )
@@ -237,9 +237,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeList,
 			Elem:     &schema.Schema{Type: schema.TypeString},
-			Required: true,
+			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ImportFromCluster), string(SubnetIDs)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ECSClusterWrapper)
 			cluster := clusterWrapper.GetECSCluster()
 			var value []string = nil
@@ -387,6 +390,17 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[ImportFromCluster] = commons.NewGenericField(
+		commons.OceanECS,
+		ImportFromCluster,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			ForceNew: true,
+		},
+		nil, nil, nil, nil,
+	)
 }
 
 func expandSubnetIDs(data interface{}) ([]string, error) {
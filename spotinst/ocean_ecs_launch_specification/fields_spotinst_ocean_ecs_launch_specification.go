@@ -9,6 +9,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_ecs"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -20,6 +21,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_ecs.ImportFromCluster), string(ImageID)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ECSClusterWrapper)
 			cluster := clusterWrapper.GetECSCluster()
 			var value = ""
@@ -61,6 +65,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_ecs.ImportFromCluster), string(IamInstanceProfile)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ECSClusterWrapper)
 			cluster := clusterWrapper.GetECSCluster()
 			var value = ""
@@ -117,10 +124,13 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		SecurityGroupIds,
 		&schema.Schema{
 			Type:     schema.TypeList,
-			Required: true,
+			Optional: true,
 			Elem:     &schema.Schema{Type: schema.TypeString},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_ecs.ImportFromCluster), string(SecurityGroupIds)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ECSClusterWrapper)
 			cluster := clusterWrapper.GetECSCluster()
 			var value []string = nil
@@ -178,6 +188,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			StateFunc: Base64StateFunc,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_ecs.ImportFromCluster), string(UserData)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ECSClusterWrapper)
 			cluster := clusterWrapper.GetECSCluster()
 			var value = ""
@@ -230,6 +243,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			if commons.IsOceanImportedField(resourceData, string(ocean_ecs.ImportFromCluster), string(KeyPair)) {
+				return nil
+			}
 			clusterWrapper := resourceObject.(*commons.ECSClusterWrapper)
 			cluster := clusterWrapper.GetECSCluster()
 			var value *string = nil
@@ -29,6 +29,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								string(S3): {
 									Type:     schema.TypeList,
 									Optional: true,
+									MaxItems: 1,
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											string(Id): {
@@ -20,4 +20,6 @@ const (
 	GPUPerUnit                       commons.FieldName = "gpu_per_unit"
 	AutoHeadroomPercentage           commons.FieldName = "auto_headroom_percentage"
 	EnableAutomaticAndManualHeadroom commons.FieldName = "enable_automatic_and_manual_headroom"
+	ExtendedResourceDefinitions      commons.FieldName = "extended_resource_definitions"
+	ExtendedResources                commons.FieldName = "extended_resources"
 )
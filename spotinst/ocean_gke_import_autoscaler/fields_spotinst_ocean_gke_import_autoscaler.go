@@ -75,6 +75,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Type:     schema.TypeInt,
 									Optional: true,
 								},
+
+								string(ExtendedResources): {
+									Type:     schema.TypeMap,
+									Optional: true,
+									Elem:     &schema.Schema{Type: schema.TypeInt},
+								},
 							},
 						},
 					},
@@ -105,6 +111,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Type:     schema.TypeInt,
 									Optional: true,
 								},
+
+								string(ExtendedResources): {
+									Type:     schema.TypeMap,
+									Optional: true,
+									Elem:     &schema.Schema{Type: schema.TypeInt},
+								},
 							},
 						},
 					},
@@ -113,6 +125,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeBool,
 						Optional: true,
 					},
+
+					string(ExtendedResourceDefinitions): {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
 				},
 			},
 		},
@@ -234,9 +252,60 @@ func expandAutoscaler(data interface{}) (*gcp.AutoScaler, error) {
 		autoscaler.SetEnableAutomaticAndManualHeadroom(spotinst.Bool(v))
 	}
 
+	if v, ok := m[string(ExtendedResourceDefinitions)]; ok {
+		extendedResourceDefinitions := expandExtendedResourceDefinitions(v)
+		if len(extendedResourceDefinitions) > 0 {
+			autoscaler.SetExtendedResourceDefinitions(extendedResourceDefinitions)
+		} else {
+			autoscaler.SetExtendedResourceDefinitions(nil)
+		}
+	}
+
 	return autoscaler, nil
 }
 
+func expandExtendedResourceDefinitions(data interface{}) []string {
+	list := data.([]interface{})
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		if resourceDefinition, ok := v.(string); ok && resourceDefinition != "" {
+			result = append(result, resourceDefinition)
+		}
+	}
+
+	return result
+}
+
+func expandExtendedResources(data interface{}) map[string]interface{} {
+	m, ok := data.(map[string]interface{})
+	if !ok || len(m) == 0 {
+		return nil
+	}
+
+	result := make(map[string]interface{}, len(m))
+	for name, amount := range m {
+		if v, ok := amount.(int); ok && v >= 0 {
+			result[name] = v
+		}
+	}
+
+	return result
+}
+
+func flattenExtendedResources(extendedResources map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(extendedResources))
+	for name, amount := range extendedResources {
+		switch v := amount.(type) {
+		case float64:
+			result[name] = int(v)
+		case int:
+			result[name] = v
+		}
+	}
+
+	return result
+}
+
 func expandOceanGCPAutoScalerDown(data interface{}) (*gcp.AutoScalerDown, error) {
 	if list := data.([]interface{}); len(list) > 0 {
 		autoScaleDown := &gcp.AutoScalerDown{}
@@ -279,6 +348,10 @@ func expandOceanGCPAutoScalerHeadroom(data interface{}) (*gcp.AutoScalerHeadroom
 			if v, ok := m[string(GPUPerUnit)].(int); ok && v >= 0 {
 				headroom.SetGPUPerUnit(spotinst.Int(v))
 			}
+
+			if v, ok := m[string(ExtendedResources)]; ok {
+				headroom.SetExtendedResources(expandExtendedResources(v))
+			}
 		}
 		return headroom, nil
 	}
@@ -300,6 +373,10 @@ func expandOceanAWSAutoScalerResourceLimits(data interface{}) (*gcp.AutoScalerRe
 				resLimits.SetMaxVCPU(spotinst.Int(v))
 			}
 
+			if v, ok := m[string(ExtendedResources)]; ok {
+				resLimits.SetExtendedResources(expandExtendedResources(v))
+			}
+
 		}
 		return resLimits, nil
 	}
@@ -331,6 +408,10 @@ func flattenAutoscaler(autoScaler *gcp.AutoScaler) []interface{} {
 			result[string(ResourceLimits)] = flattenAutoScaleResourceLimits(autoScaler.ResourceLimits)
 		}
 
+		if autoScaler.ExtendedResourceDefinitions != nil {
+			result[string(ExtendedResourceDefinitions)] = autoScaler.ExtendedResourceDefinitions
+		}
+
 		if len(result) > 0 {
 			out = append(out, result)
 		}
@@ -346,6 +427,10 @@ func flattenAutoScaleHeadroom(autoScaleHeadroom *gcp.AutoScalerHeadroom) []inter
 	headRoom[string(NumOfUnits)] = spotinst.IntValue(autoScaleHeadroom.NumOfUnits)
 	headRoom[string(GPUPerUnit)] = spotinst.IntValue(autoScaleHeadroom.GPUPerUnit)
 
+	if autoScaleHeadroom.ExtendedResources != nil {
+		headRoom[string(ExtendedResources)] = flattenExtendedResources(autoScaleHeadroom.ExtendedResources)
+	}
+
 	return []interface{}{headRoom}
 }
 
@@ -361,5 +446,9 @@ func flattenAutoScaleResourceLimits(autoScalerResourceLimits *gcp.AutoScalerReso
 	down := make(map[string]interface{})
 	down[string(MaxVCpu)] = spotinst.IntValue(autoScalerResourceLimits.MaxVCPU)
 	down[string(MaxMemoryGib)] = spotinst.IntValue(autoScalerResourceLimits.MaxMemoryGiB)
+
+	if autoScalerResourceLimits.ExtendedResources != nil {
+		down[string(ExtendedResources)] = flattenExtendedResources(autoScalerResourceLimits.ExtendedResources)
+	}
 	return []interface{}{down}
 }
@@ -0,0 +1,13 @@
+package ocean_gke_import_logging
+
+import (
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+const (
+	Logging commons.FieldName = "logging"
+	Export  commons.FieldName = "export"
+	S3      commons.FieldName = "s3"
+	GCS     commons.FieldName = "gcs"
+	Id      commons.FieldName = "id"
+)
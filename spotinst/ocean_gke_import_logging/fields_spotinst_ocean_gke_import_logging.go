@@ -0,0 +1,216 @@
+package ocean_gke_import_logging
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[Logging] = commons.NewGenericField(
+		commons.OceanGKEImportLogging,
+		Logging,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(Export): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(S3): {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											string(Id): {
+												Type:     schema.TypeString,
+												Required: true,
+											},
+										},
+									},
+								},
+
+								string(GCS): {
+									Type:     schema.TypeList,
+									Optional: true,
+									MaxItems: 1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											string(Id): {
+												Type:     schema.TypeString,
+												Required: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEImportClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var result []interface{} = nil
+			if cluster != nil && cluster.Logging != nil {
+				result = flattenLogging(cluster.Logging)
+			}
+			if len(result) > 0 {
+				if err := resourceData.Set(string(Logging), result); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Logging), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEImportClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if v, ok := resourceData.GetOk(string(Logging)); ok {
+				if logging, err := expandOceanGKELogging(v); err != nil {
+					return err
+				} else {
+					cluster.SetLogging(logging)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.GKEImportClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *gcp.Logging = nil
+
+			if v, ok := resourceData.GetOk(string(Logging)); ok {
+				if logging, err := expandOceanGKELogging(v); err != nil {
+					return err
+				} else {
+					value = logging
+				}
+			}
+			cluster.SetLogging(value)
+			return nil
+		},
+		nil,
+	)
+}
+
+func flattenLogging(logging *gcp.Logging) []interface{} {
+	var out []interface{}
+
+	if logging != nil {
+		result := make(map[string]interface{})
+
+		if logging.Export != nil {
+			result[string(Export)] = flattenExport(logging.Export)
+		}
+
+		if len(result) > 0 {
+			out = append(out, result)
+		}
+	}
+
+	return out
+}
+
+func flattenExport(export *gcp.Export) []interface{} {
+	var out []interface{}
+
+	if export != nil {
+		result := make(map[string]interface{})
+
+		if export.S3 != nil && export.S3.ID != nil {
+			result[string(S3)] = []interface{}{
+				map[string]interface{}{string(Id): spotinst.StringValue(export.S3.ID)},
+			}
+		}
+
+		if export.GCS != nil && export.GCS.ID != nil {
+			result[string(GCS)] = []interface{}{
+				map[string]interface{}{string(Id): spotinst.StringValue(export.GCS.ID)},
+			}
+		}
+
+		if len(result) > 0 {
+			out = append(out, result)
+		}
+	}
+
+	return out
+}
+
+func expandOceanGKELogging(data interface{}) (*gcp.Logging, error) {
+	logging := &gcp.Logging{}
+	list := data.([]interface{})
+
+	if list == nil || list[0] == nil {
+		return logging, nil
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(Export)]; ok {
+		export, err := expandOceanGKEExport(v)
+		if err != nil {
+			return nil, err
+		}
+		if export != nil {
+			logging.SetExport(export)
+		} else {
+			logging.Export = nil
+		}
+	}
+
+	return logging, nil
+}
+
+func expandOceanGKEExport(data interface{}) (*gcp.Export, error) {
+	export := &gcp.Export{}
+	list := data.([]interface{})
+
+	if list == nil || list[0] == nil {
+		return export, nil
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(S3)]; ok {
+		if id := expandDataIntegrationId(v); id != nil {
+			export.SetS3(&gcp.S3{ID: id})
+		} else {
+			export.S3 = nil
+		}
+	}
+
+	if v, ok := m[string(GCS)]; ok {
+		if id := expandDataIntegrationId(v); id != nil {
+			export.SetGCS(&gcp.GCS{ID: id})
+		} else {
+			export.GCS = nil
+		}
+	}
+
+	return export, nil
+}
+
+func expandDataIntegrationId(data interface{}) *string {
+	list := data.([]interface{})
+
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(Id)].(string); ok && v != "" {
+		return spotinst.String(v)
+	}
+
+	return nil
+}
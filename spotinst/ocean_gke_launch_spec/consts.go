@@ -22,6 +22,8 @@ const (
 	GPUPerUnit    commons.FieldName = "gpu_per_unit"
 	MemoryPerUnit commons.FieldName = "memory_per_unit"
 	NumOfUnits    commons.FieldName = "num_of_units"
+
+	ExtendedResources commons.FieldName = "extended_resources"
 )
 
 const (
@@ -57,6 +59,10 @@ const (
 	NodePoolName commons.FieldName = "node_pool_name"
 )
 
+const (
+	GPU commons.FieldName = "gpu"
+)
+
 const (
 	UpdatePolicy commons.FieldName = "update_policy"
 	ShouldRoll   commons.FieldName = "should_roll"
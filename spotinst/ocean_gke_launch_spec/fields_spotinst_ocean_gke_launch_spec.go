@@ -2,11 +2,15 @@ package ocean_gke_launch_spec
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	elastigroupGCP "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_gcp_gpu"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -475,6 +479,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeInt,
 						Required: true,
 					},
+
+					string(ExtendedResources): {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeInt},
+					},
 				},
 			},
 		},
@@ -734,6 +744,58 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[GPU] = commons.NewGenericField(
+		commons.OceanGKELaunchSpec,
+		GPU,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: gpuSchema(),
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.LaunchSpecGKEWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			var result []interface{} = nil
+			if launchSpec.GPU != nil {
+				result = flattenGPU(launchSpec.GPU)
+			}
+			if err := resourceData.Set(string(GPU), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(GPU), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.LaunchSpecGKEWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			if v, ok := resourceData.GetOk(string(GPU)); ok {
+				if gpu, err := expandGPU(v); err != nil {
+					return err
+				} else {
+					launchSpec.SetGPU(gpu)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			launchSpecWrapper := resourceObject.(*commons.LaunchSpecGKEWrapper)
+			launchSpec := launchSpecWrapper.GetLaunchSpec()
+			var gpu *gcp.GPU = nil
+			if v, ok := resourceData.GetOk(string(GPU)); ok {
+				if value, err := expandGPU(v); err != nil {
+					return err
+				} else {
+					gpu = value
+				}
+			}
+			launchSpec.SetGPU(gpu)
+			return nil
+		},
+		nil,
+	)
+
 	fieldsMap[ShieldedInstanceConfig] = commons.NewGenericField(
 		commons.OceanGKELaunchSpec,
 		ShieldedInstanceConfig,
@@ -1448,6 +1510,14 @@ func expandHeadrooms(data interface{}) ([]*gcp.AutoScaleHeadroom, error) {
 			MemoryPerUnit: spotinst.Int(attr[string(MemoryPerUnit)].(int)),
 		}
 
+		if v, ok := attr[string(ExtendedResources)].(map[string]interface{}); ok && len(v) > 0 {
+			extendedResources := make(map[string]interface{}, len(v))
+			for name, amount := range v {
+				extendedResources[name] = amount
+			}
+			headroom.SetExtendedResources(extendedResources)
+		}
+
 		headrooms = append(headrooms, headroom)
 	}
 	return headrooms, nil
@@ -1484,6 +1554,18 @@ func flattenHeadrooms(headrooms []*gcp.AutoScaleHeadroom) []interface{} {
 		m[string(NumOfUnits)] = spotinst.IntValue(headroom.NumOfUnits)
 		m[string(MemoryPerUnit)] = spotinst.IntValue(headroom.MemoryPerUnit)
 
+		if headroom.ExtendedResources != nil {
+			extendedResources := make(map[string]interface{}, len(headroom.ExtendedResources))
+			for name, amount := range headroom.ExtendedResources {
+				if v, ok := amount.(float64); ok {
+					extendedResources[name] = int(v)
+				} else {
+					extendedResources[name] = amount
+				}
+			}
+			m[string(ExtendedResources)] = extendedResources
+		}
+
 		result = append(result, m)
 	}
 
@@ -1521,3 +1603,91 @@ func checkTaintKeyExists(taint *gcp.Taint, taints []*gcp.Taint) bool {
 	}
 	return false
 }
+
+// ValidateGPU checks that GPU accelerators are only requested for N1 machine
+// types, and that no headroom unit requests more GPUs than a node provides.
+// Values that are not known yet are not validated.
+func ValidateGPU(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown(string(GPU)) {
+		return nil
+	}
+
+	count := 0
+	if v, ok := diff.GetOk(string(GPU)); ok {
+		gpu, err := elastigroup_gcp_gpu.ExpandGPU(v)
+		if err != nil {
+			return err
+		}
+		count = spotinst.IntValue(gpu.Count)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	if diff.NewValueKnown(string(InstanceTypes)) {
+		if v, ok := diff.GetOk(string(InstanceTypes)); ok {
+			for _, instanceType := range v.([]interface{}) {
+				if name, ok := instanceType.(string); ok && !isN1MachineType(name) {
+					return fmt.Errorf("%s can only be attached to N1 machine types, got %q in %s",
+						string(GPU), name, string(InstanceTypes))
+				}
+			}
+		}
+	}
+
+	if diff.NewValueKnown(string(AutoscaleHeadrooms)) {
+		if v, ok := diff.GetOk(string(AutoscaleHeadrooms)); ok {
+			for _, headroom := range v.(*schema.Set).List() {
+				m, ok := headroom.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if gpuPerUnit, ok := m[string(GPUPerUnit)].(int); ok && gpuPerUnit > count {
+					return fmt.Errorf("%s.%s (%d) is greater than the %s.%s of a node (%d)",
+						string(AutoscaleHeadrooms), string(GPUPerUnit), gpuPerUnit, string(GPU), string(elastigroup_gcp_gpu.Count), count)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// isN1MachineType reports whether name is an N1 machine type. N1 custom
+// machine types are named "custom-<cpus>-<memory>", without the series
+// prefix other series use (e.g. "n2-custom-").
+func isN1MachineType(name string) bool {
+	return strings.HasPrefix(name, "n1-") || strings.HasPrefix(name, "custom-")
+}
+
+// gpuSchema returns the GPU block schema shared with Elastigroups, limited to
+// the GPU counts that can be attached to a node.
+func gpuSchema() map[string]*schema.Schema {
+	s := elastigroup_gcp_gpu.GPUSchema()
+	s[string(elastigroup_gcp_gpu.Count)].ValidateFunc = validation.IntInSlice([]int{1, 2, 4, 8})
+	return s
+}
+
+// expandGPU expands the GPU block with the expander shared with Elastigroups.
+func expandGPU(data interface{}) (*gcp.GPU, error) {
+	value, err := elastigroup_gcp_gpu.ExpandGPU(data)
+	if err != nil {
+		return nil, err
+	}
+
+	gpu := &gcp.GPU{}
+	if value.Type != nil {
+		gpu.SetType(value.Type)
+	}
+	if value.Count != nil {
+		gpu.SetCount(value.Count)
+	}
+	return gpu, nil
+}
+
+// flattenGPU flattens the GPU with the flattener shared with Elastigroups.
+func flattenGPU(gpu *gcp.GPU) []interface{} {
+	return elastigroup_gcp_gpu.FlattenGPU(&elastigroupGCP.GPU{
+		Type:  gpu.Type,
+		Count: gpu.Count,
+	})
+}
@@ -3,5 +3,16 @@ package ocean_spark
 import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 
 const (
-	OceanClusterID commons.FieldName = "ocean_cluster_id"
+	OceanClusterID      commons.FieldName = "ocean_cluster_id"
+	WaitForReady        commons.FieldName = "wait_for_ready"
+	WaitForReadyTimeout commons.FieldName = "wait_for_ready_timeout"
+	State               commons.FieldName = "state"
+	OperatorVersion     commons.FieldName = "operator_version"
+	IngressEndpoint     commons.FieldName = "ingress_endpoint"
+	LastError           commons.FieldName = "last_error"
+)
+
+const (
+	StateAvailable = "AVAILABLE"
+	StateFailed    = "FAILED"
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -42,4 +43,42 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[WaitForReady] = commons.NewGenericField(
+		commons.OceanSpark,
+		WaitForReady,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForReadyTimeout] = commons.NewGenericField(
+		commons.OceanSpark,
+		WaitForReadyTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1800,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[State] = commons.NewComputedStringField(commons.OceanSpark, State, func(resourceObject interface{}) *string {
+		return resourceObject.(*commons.SparkClusterWrapper).GetCluster().State
+	})
+
+	fieldsMap[OperatorVersion] = commons.NewComputedStringField(commons.OceanSpark, OperatorVersion, func(resourceObject interface{}) *string {
+		return resourceObject.(*commons.SparkClusterWrapper).GetCluster().OperatorVersion
+	})
+
+	fieldsMap[IngressEndpoint] = commons.NewComputedStringField(commons.OceanSpark, IngressEndpoint, func(resourceObject interface{}) *string {
+		return resourceObject.(*commons.SparkClusterWrapper).GetCluster().IngressEndpoint
+	})
+
+	fieldsMap[LastError] = commons.NewComputedStringField(commons.OceanSpark, LastError, func(resourceObject interface{}) *string {
+		return resourceObject.(*commons.SparkClusterWrapper).GetCluster().LastError
+	})
 }
@@ -0,0 +1,50 @@
+package ocean_spark_application
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	OceanSparkClusterID      commons.FieldName = "ocean_spark_id"
+	JobID                    commons.FieldName = "job_id"
+	AppName                  commons.FieldName = "app_name"
+	Image                    commons.FieldName = "image"
+	Type                     commons.FieldName = "type"
+	MainClass                commons.FieldName = "main_class"
+	MainApplicationFile      commons.FieldName = "main_application_file"
+	Arguments                commons.FieldName = "arguments"
+	SparkVersion             commons.FieldName = "spark_version"
+	SparkConf                commons.FieldName = "spark_conf"
+	Driver                   commons.FieldName = "driver"
+	Executor                 commons.FieldName = "executor"
+	WaitForCompletion        commons.FieldName = "wait_for_completion"
+	WaitForCompletionTimeout commons.FieldName = "wait_for_completion_timeout"
+	AppID                    commons.FieldName = "app_id"
+	State                    commons.FieldName = "state"
+	LogURL                   commons.FieldName = "log_url"
+)
+
+const (
+	Cores     commons.FieldName = "cores"
+	Memory    commons.FieldName = "memory"
+	Instances commons.FieldName = "instances"
+)
+
+const (
+	StateCompleted = "COMPLETED"
+	StateFailed    = "FAILED"
+	StateKilled    = "KILLED"
+	StateTimedOut  = "TIMED_OUT"
+)
+
+// TerminalStates holds the application states from which an application never
+// transitions again.
+var TerminalStates = []string{StateCompleted, StateFailed, StateKilled, StateTimedOut}
+
+// IsTerminalState reports whether the application will not transition from state.
+func IsTerminalState(state string) bool {
+	for _, s := range TerminalStates {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,319 @@
+package ocean_spark_application
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/spark"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[OceanSparkClusterID] = commons.NewGenericField(
+		commons.OceanSparkApplication,
+		OceanSparkClusterID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if app.ClusterID != nil {
+				if err := resourceData.Set(string(OceanSparkClusterID), spotinst.StringValue(app.ClusterID)); err != nil {
+					return fmt.Errorf(commons.FailureFieldReadPattern, string(OceanSparkClusterID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			app.ClusterID = spotinst.String(resourceData.Get(string(OceanSparkClusterID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[JobID] = commons.NewGenericField(
+		commons.OceanSparkApplication,
+		JobID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if err := resourceData.Set(string(JobID), spotinst.StringValue(app.JobID)); err != nil {
+				return fmt.Errorf(commons.FailureFieldReadPattern, string(JobID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if v, ok := resourceData.GetOk(string(JobID)); ok {
+				app.SetJobID(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[AppName] = commons.NewGenericField(
+		commons.OceanSparkApplication,
+		AppName,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if err := resourceData.Set(string(AppName), spotinst.StringValue(app.AppName)); err != nil {
+				return fmt.Errorf(commons.FailureFieldReadPattern, string(AppName), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if v, ok := resourceData.GetOk(string(AppName)); ok {
+				app.SetAppName(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Image] = newConfigOverridesStringField(Image, nil, func(overrides *spark.ConfigOverrides, v *string) {
+		overrides.SetImage(v)
+	})
+
+	fieldsMap[Type] = newConfigOverridesStringField(Type,
+		validation.StringInSlice([]string{"Scala", "Java", "Python", "R"}, false),
+		func(overrides *spark.ConfigOverrides, v *string) {
+			overrides.SetType(v)
+		})
+
+	fieldsMap[MainClass] = newConfigOverridesStringField(MainClass, nil, func(overrides *spark.ConfigOverrides, v *string) {
+		overrides.SetMainClass(v)
+	})
+
+	fieldsMap[MainApplicationFile] = newConfigOverridesStringField(MainApplicationFile, nil, func(overrides *spark.ConfigOverrides, v *string) {
+		overrides.SetMainApplicationFile(v)
+	})
+
+	fieldsMap[SparkVersion] = newConfigOverridesStringField(SparkVersion, nil, func(overrides *spark.ConfigOverrides, v *string) {
+		overrides.SetSparkVersion(v)
+	})
+
+	fieldsMap[Arguments] = commons.NewGenericField(
+		commons.OceanSparkApplication,
+		Arguments,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if v, ok := resourceData.GetOk(string(Arguments)); ok {
+				list := v.([]interface{})
+				args := make([]*string, 0, len(list))
+				for _, arg := range list {
+					args = append(args, spotinst.String(arg.(string)))
+				}
+				app.ConfigOverrides.SetArguments(args)
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[SparkConf] = commons.NewGenericField(
+		commons.OceanSparkApplication,
+		SparkConf,
+		&schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if v, ok := resourceData.GetOk(string(SparkConf)); ok {
+				conf := make(map[string]string)
+				for key, value := range v.(map[string]interface{}) {
+					conf[key] = value.(string)
+				}
+				app.ConfigOverrides.SetSparkConf(conf)
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Driver] = newPodSpecField(Driver, false, func(overrides *spark.ConfigOverrides, spec *spark.PodSpec) {
+		overrides.SetDriver(spec)
+	})
+
+	fieldsMap[Executor] = newPodSpecField(Executor, true, func(overrides *spark.ConfigOverrides, spec *spark.PodSpec) {
+		overrides.SetExecutor(spec)
+	})
+
+	fieldsMap[WaitForCompletion] = commons.NewGenericField(
+		commons.OceanSparkApplication,
+		WaitForCompletion,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForCompletionTimeout] = commons.NewGenericField(
+		commons.OceanSparkApplication,
+		WaitForCompletionTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      3600,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[AppID] = commons.NewComputedStringField(commons.OceanSparkApplication, AppID, func(resourceObject interface{}) *string {
+		return resourceObject.(*commons.SparkApplicationWrapper).GetApplication().ID
+	})
+
+	fieldsMap[State] = commons.NewComputedStringField(commons.OceanSparkApplication, State, func(resourceObject interface{}) *string {
+		return resourceObject.(*commons.SparkApplicationWrapper).GetApplication().AppState
+	})
+
+	fieldsMap[LogURL] = commons.NewComputedStringField(commons.OceanSparkApplication, LogURL, func(resourceObject interface{}) *string {
+		return resourceObject.(*commons.SparkApplicationWrapper).GetApplication().LogURL
+	})
+}
+
+// newConfigOverridesStringField returns a field submitted as part of the
+// application config overrides. Config overrides only apply at submission, so
+// they are not read back and changing them submits a new application.
+func newConfigOverridesStringField(fieldName commons.FieldName, validateFunc schema.SchemaValidateFunc,
+	set func(overrides *spark.ConfigOverrides, v *string)) *commons.GenericField {
+	return commons.NewGenericField(
+		commons.OceanSparkApplication,
+		fieldName,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validateFunc,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if v, ok := resourceData.GetOk(string(fieldName)); ok {
+				set(app.ConfigOverrides, spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+}
+
+func newPodSpecField(fieldName commons.FieldName, withInstances bool,
+	set func(overrides *spark.ConfigOverrides, spec *spark.PodSpec)) *commons.GenericField {
+	elem := map[string]*schema.Schema{
+		string(Cores): {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		string(Memory): {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+	}
+	if withInstances {
+		elem[string(Instances)] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		}
+	}
+
+	return commons.NewGenericField(
+		commons.OceanSparkApplication,
+		fieldName,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem:     &schema.Resource{Schema: elem},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			appWrapper := resourceObject.(*commons.SparkApplicationWrapper)
+			app := appWrapper.GetApplication()
+			if v, ok := resourceData.GetOk(string(fieldName)); ok {
+				set(app.ConfigOverrides, expandPodSpec(v))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+}
+
+func expandPodSpec(data interface{}) *spark.PodSpec {
+	spec := &spark.PodSpec{}
+	list := data.([]interface{})
+	if list == nil || list[0] == nil {
+		return spec
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(Cores)].(int); ok && v > 0 {
+		spec.SetCores(spotinst.Int(v))
+	}
+
+	if v, ok := m[string(Memory)].(string); ok && v != "" {
+		spec.SetMemory(spotinst.String(v))
+	}
+
+	if v, ok := m[string(Instances)].(int); ok && v > 0 {
+		spec.SetInstances(spotinst.Int(v))
+	}
+
+	return spec
+}
@@ -0,0 +1,9 @@
+package ocean_spark_spark
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	Spark               commons.FieldName = "spark"
+	AppNamespaces       commons.FieldName = "app_namespaces"
+	DefaultSparkVersion commons.FieldName = "default_spark_version"
+)
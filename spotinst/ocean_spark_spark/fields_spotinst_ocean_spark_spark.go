@@ -0,0 +1,143 @@
+package ocean_spark_spark
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/spark"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	fieldsMap[Spark] = commons.NewGenericField(
+		commons.OceanSparkSpark,
+		Spark,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+
+					string(AppNamespaces): {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Computed: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+
+					string(DefaultSparkVersion): {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.SparkClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var result []interface{} = nil
+			if cluster.Config != nil && cluster.Config.Spark != nil {
+				result = flattenSpark(cluster.Config.Spark)
+			}
+			if len(result) > 0 {
+				if err := resourceData.Set(string(Spark), result); err != nil {
+					return fmt.Errorf(commons.FailureFieldReadPattern, string(Spark), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.SparkClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			if value, ok := resourceData.GetOk(string(Spark)); ok {
+				if sparkConfig, err := expandSpark(value, false); err != nil {
+					return err
+				} else {
+					if cluster.Config == nil {
+						cluster.Config = &spark.Config{}
+					}
+					cluster.Config.SetSpark(sparkConfig)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.SparkClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var value *spark.SparkConfig = nil
+			if v, ok := resourceData.GetOk(string(Spark)); ok {
+				if sparkConfig, err := expandSpark(v, true); err != nil {
+					return err
+				} else {
+					value = sparkConfig
+				}
+			}
+			if cluster.Config == nil {
+				cluster.Config = &spark.Config{}
+			}
+			cluster.Config.SetSpark(value)
+			return nil
+		},
+		nil,
+	)
+}
+
+func flattenSpark(sparkConfig *spark.SparkConfig) []interface{} {
+	if sparkConfig == nil {
+		return nil
+	}
+	result := make(map[string]interface{})
+	result[string(DefaultSparkVersion)] = spotinst.StringValue(sparkConfig.DefaultSparkVersion)
+	if sparkConfig.AppNamespaces != nil {
+		namespaces := make([]interface{}, 0, len(sparkConfig.AppNamespaces))
+		for _, namespace := range sparkConfig.AppNamespaces {
+			namespaces = append(namespaces, spotinst.StringValue(namespace))
+		}
+		result[string(AppNamespaces)] = namespaces
+	}
+	return []interface{}{result}
+}
+
+func expandSpark(data interface{}, nullify bool) (*spark.SparkConfig, error) {
+	sparkConfig := &spark.SparkConfig{}
+	list := data.([]interface{})
+	if list == nil || list[0] == nil {
+		return sparkConfig, nil
+	}
+	m := list[0].(map[string]interface{})
+
+	if v, ok := m[string(DefaultSparkVersion)].(string); ok && v != "" {
+		sparkConfig.SetDefaultSparkVersion(spotinst.String(v))
+	} else if nullify {
+		sparkConfig.SetDefaultSparkVersion(nil)
+	}
+
+	if v, ok := m[string(AppNamespaces)]; ok {
+		namespaces := expandAppNamespaces(v)
+		if len(namespaces) > 0 {
+			sparkConfig.SetAppNamespaces(namespaces)
+		} else {
+			if nullify {
+				sparkConfig.SetAppNamespaces(nil)
+			}
+		}
+	}
+
+	return sparkConfig, nil
+}
+
+func expandAppNamespaces(data interface{}) []*string {
+	list := data.(*schema.Set).List()
+	result := make([]*string, 0, len(list))
+	for _, v := range list {
+		if namespace, ok := v.(string); ok && namespace != "" {
+			result = append(result, spotinst.String(namespace))
+		}
+	}
+	return result
+}
@@ -0,0 +1,8 @@
+package ocean_spark_virtual_node_group
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	OceanSparkClusterID commons.FieldName = "ocean_spark_id"
+	VirtualNodeGroupID  commons.FieldName = "virtual_node_group_id"
+)
@@ -0,0 +1,73 @@
+package ocean_spark_virtual_node_group
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[OceanSparkClusterID] = commons.NewGenericField(
+		commons.OceanSparkVirtualNodeGroup,
+		OceanSparkClusterID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			vngWrapper := resourceObject.(*commons.SparkVirtualNodeGroupWrapper)
+			vng := vngWrapper.GetVirtualNodeGroup()
+			if vng.OceanSparkClusterID != nil {
+				if err := resourceData.Set(string(OceanSparkClusterID), spotinst.StringValue(vng.OceanSparkClusterID)); err != nil {
+					return fmt.Errorf(commons.FailureFieldReadPattern, string(OceanSparkClusterID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			vngWrapper := resourceObject.(*commons.SparkVirtualNodeGroupWrapper)
+			vng := vngWrapper.GetVirtualNodeGroup()
+			vng.OceanSparkClusterID = spotinst.String(resourceData.Get(string(OceanSparkClusterID)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern), string(OceanSparkClusterID))
+		},
+		nil,
+	)
+
+	fieldsMap[VirtualNodeGroupID] = commons.NewGenericField(
+		commons.OceanSparkVirtualNodeGroup,
+		VirtualNodeGroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			vngWrapper := resourceObject.(*commons.SparkVirtualNodeGroupWrapper)
+			vng := vngWrapper.GetVirtualNodeGroup()
+			if vng.VngID != nil {
+				if err := resourceData.Set(string(VirtualNodeGroupID), spotinst.StringValue(vng.VngID)); err != nil {
+					return fmt.Errorf(commons.FailureFieldReadPattern, string(VirtualNodeGroupID), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			vngWrapper := resourceObject.(*commons.SparkVirtualNodeGroupWrapper)
+			vng := vngWrapper.GetVirtualNodeGroup()
+			vng.VngID = spotinst.String(resourceData.Get(string(VirtualNodeGroupID)).(string))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return fmt.Errorf(string(commons.FieldUpdateNotAllowedPattern), string(VirtualNodeGroupID))
+		},
+		nil,
+	)
+}
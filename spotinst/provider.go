@@ -43,11 +43,16 @@ func Provider() *schema.Provider {
 			string(commons.ElastigroupAzureResourceName):        resourceSpotinstElastigroupAzure(),
 			string(commons.ElastigroupAzureV3ResourceName):      resourceSpotinstElastigroupAzureV3(),
 			string(commons.SubscriptionResourceName):            resourceSpotinstSubscription(),
+			string(commons.NotificationPolicyResourceName):      resourceSpotinstNotificationPolicy(),
 			string(commons.MRScalerAWSResourceName):             resourceSpotinstMRScalerAWS(),
 
+			string(commons.ElastigroupAWSStatefulInstanceResourceName): resourceSpotinstElastigroupAWSStatefulInstance(),
+			string(commons.ElastigroupAWSImportResourceName):           resourceSpotinstElastigroupAWSImport(),
+
 			// Ocean.
 			string(commons.OceanAWSResourceName):                 resourceSpotinstOceanAWS(),
 			string(commons.OceanAWSLaunchSpecResourceName):       resourceSpotinstOceanAWSLaunchSpec(),
+			string(commons.OceanAWSLaunchSpecImportResourceName): resourceSpotinstOceanAWSLaunchSpecImport(),
 			string(commons.OceanGKEImportResourceName):           resourceSpotinstOceanGKEImport(),
 			string(commons.OceanGKELaunchSpecResourceName):       resourceSpotinstOceanGKELaunchSpec(),
 			string(commons.OceanGKELaunchSpecImportResourceName): resourceSpotinstOceanGKELaunchSpecImport(),
@@ -57,12 +62,16 @@ func Provider() *schema.Provider {
 			string(commons.OceanAKSVirtualNodeGroupResourceName): resourceSpotinstOceanAKSVirtualNodeGroup(),
 
 			// Multai.
-			string(commons.MultaiBalancerResourceName):    resourceSpotinstMultaiBalancer(),
-			string(commons.MultaiDeploymentResourceName):  resourceSpotinstMultaiDeployment(),
-			string(commons.MultaiListenerResourceName):    resourceSpotinstMultaiListener(),
-			string(commons.MultaiRoutingRuleResourceName): resourceSpotinstMultaiRoutingRule(),
-			string(commons.MultaiTargetResourceName):      resourceSpotinstMultaiTarget(),
-			string(commons.MultaiTargetSetResourceName):   resourceSpotinstMultaiTargetSet(),
+			string(commons.MultaiBalancerResourceName):            resourceSpotinstMultaiBalancer(),
+			string(commons.MultaiBlueGreenResourceName):           resourceSpotinstMultaiBlueGreen(),
+			string(commons.MultaiCertificateResourceName):         resourceSpotinstMultaiCertificate(),
+			string(commons.MultaiDeploymentResourceName):          resourceSpotinstMultaiDeployment(),
+			string(commons.MultaiListenerResourceName):            resourceSpotinstMultaiListener(),
+			string(commons.MultaiMiddlewareResourceName):          resourceSpotinstMultaiMiddleware(),
+			string(commons.MultaiRoutingRuleResourceName):         resourceSpotinstMultaiRoutingRule(),
+			string(commons.MultaiTargetResourceName):              resourceSpotinstMultaiTarget(),
+			string(commons.MultaiTargetSetResourceName):           resourceSpotinstMultaiTargetSet(),
+			string(commons.MultaiTargetSetAttachmentResourceName): resourceSpotinstMultaiTargetSetAttachment(),
 
 			// Managed Instance.
 			string(commons.ManagedInstanceAWSResourceName): resourceSpotinstMangedInstanceAWS(),
@@ -75,6 +84,7 @@ func Provider() *schema.Provider {
 
 			// ExtendedResourceDefinition
 			string(commons.OceanAWSExtendedResourceDefinitionResourceName): resourceSpotinstOceanAWSExtendedResourceDefinition(),
+			string(commons.OceanGKEExtendedResourceDefinitionResourceName): resourceSpotinstOceanGKEExtendedResourceDefinition(),
 
 			// Data Integration
 			string(commons.DataIntegrationResourceName): resourceSpotinstDataIntegration(),
@@ -83,7 +93,18 @@ func Provider() *schema.Provider {
 			string(commons.StatefulNodeAzureResourceName): resourceSpotinstStatefulNodeAzureV3(),
 
 			// Ocean Spark
-			string(commons.OceanSparkResourceName): resourceSpotinstOceanSpark(),
+			string(commons.OceanSparkResourceName):                 resourceSpotinstOceanSpark(),
+			string(commons.OceanSparkVirtualNodeGroupResourceName): resourceSpotinstOceanSparkVirtualNodeGroup(),
+			string(commons.OceanSparkApplicationResourceName):      resourceSpotinstOceanSparkApplication(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			// Subscription
+			string(commons.SubscriptionEventTypesDataSourceName): dataSourceSpotinstSubscriptionEventTypes(),
+
+			// Elastigroup
+			string(commons.ElastigroupAWSInstancesDataSourceName):         dataSourceSpotinstElastigroupAWSInstances(),
+			string(commons.ElastigroupAWSStatefulInstancesDataSourceName): dataSourceSpotinstElastigroupAWSStatefulInstances(),
 		},
 	}
 
@@ -29,6 +29,8 @@ func resourceSpotinstDataIntegration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceSpotinstDataIntegrationCustomizeDiff,
+
 		Schema: commons.DataIntegrationResource.GetSchemaMap(),
 	}
 }
@@ -42,6 +44,22 @@ func setupDataIntegrationResource() {
 
 const ErrCodeDataIntegrationNotFound = "DATA_INTEGRATION_DOESNT_EXIST"
 
+func resourceSpotinstDataIntegrationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	configured := make([]string, 0, len(dataintegration.VendorFields))
+	for vendor, field := range dataintegration.VendorFields {
+		if v, ok := diff.GetOk(string(field)); ok && len(v.([]interface{})) > 0 {
+			configured = append(configured, vendor)
+		}
+	}
+
+	vendor := ""
+	if diff.NewValueKnown(string(dataintegration.Vendor)) {
+		vendor = diff.Get(string(dataintegration.Vendor)).(string)
+	}
+
+	return dataintegration.ValidateVendorConfig(vendor, configured)
+}
+
 func resourceSpotinstDataIntegrationRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead), commons.DataIntegrationResource.GetName(), resourceId)
@@ -91,6 +109,13 @@ func resourceSpotinstDataIntegrationCreate(ctx context.Context, resourceData *sc
 
 	resourceData.SetId(spotinst.StringValue(DataIntegrationId))
 
+	if wait, ok := resourceData.GetOk(string(dataintegration.WaitForVerification)); ok && wait.(bool) {
+		timeout := resourceData.Get(string(dataintegration.WaitForVerificationTimeout)).(int)
+		if err := awaitDataIntegrationVerified(ctx, DataIntegrationId, timeout, meta.(*Client)); err != nil {
+			return diag.Errorf("[ERROR] Failed to verify data integration: %s", err)
+		}
+	}
+
 	log.Printf("===> DataIntegration created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstDataIntegrationRead(ctx, resourceData, meta)
@@ -121,6 +146,27 @@ func createDataIntegration(resourceData *schema.ResourceData, di *aws.DataIntegr
 
 }
 
+func awaitDataIntegrationVerified(ctx context.Context, dataIntegrationId *string, timeout int, client *Client) error {
+	description := fmt.Sprintf("data integration [%v] to be verified", spotinst.StringValue(dataIntegrationId))
+	return commons.AwaitState(ctx, description, timeout, func() (string, bool, error) {
+		input := &aws.ReadDataIntegrationInput{DataIntegrationId: dataIntegrationId}
+		resp, err := client.dataIntegration.CloudProviderAWS().ReadDataIntegration(ctx, input)
+		if err != nil {
+			return "", false, fmt.Errorf("[ERROR] awaitDataIntegrationVerified() -> readDataIntegration [%v] API call failed, error: %v", spotinst.StringValue(dataIntegrationId), err)
+		}
+
+		status := ""
+		if resp.DataIntegration != nil {
+			status = spotinst.StringValue(resp.DataIntegration.Status)
+		}
+
+		if status == dataintegration.StatusFailed {
+			return status, false, fmt.Errorf("data integration [%v] failed verification, check the permissions on the target bucket", spotinst.StringValue(dataIntegrationId))
+		}
+		return status, status == dataintegration.StatusVerified, nil
+	})
+}
+
 func resourceSpotinstDataIntegrationUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.DataIntegrationResource.GetName(), resourceId)
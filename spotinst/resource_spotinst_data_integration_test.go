@@ -78,23 +78,10 @@ func createDataIntegrationTerraform(dim *DataIntegrationMetadata, formatToUse st
 	 account = "fake"
 	}
 	`
-	//format := formatToUse
-
-	if dim.updateBaselineFields {
-		format := testBaselineDataIntegrationConfig_Update
-		template += fmt.Sprintf(format,
-			dim.name,
-			dim.provider,
-			//dim.fieldsToAppend,
-		)
-	} else {
-		format := testBaselineDataIntegrationConfig_Create
-		template += fmt.Sprintf(format,
-			dim.name,
-			dim.provider,
-			//dim.fieldsToAppend,
-		)
-	}
+	template += fmt.Sprintf(formatToUse,
+		dim.name,
+		dim.provider,
+	)
 
 	if dim.variables != "" {
 		template = dim.variables + "\n" + template
@@ -125,6 +112,7 @@ func TestAccSpotinstDataIntegration_Baseline(t *testing.T) {
 					testCheckDataIntegrationExists(&di, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "name", "foo"),
 					resource.TestCheckResourceAttr(resourceName, "status", "enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "verification_status"),
 					resource.TestCheckResourceAttr(resourceName, "s3.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "s3.0.bucket_name", "terraform-test-do-not-delete"),
 					resource.TestCheckResourceAttr(resourceName, "s3.0.subdir", "terraform-test-data-integration"),
@@ -173,3 +161,52 @@ resource "` + string(commons.DataIntegrationResourceName) + `" "%v" {
 `
 
 // endregion
+
+// region DataIntegration: GCS
+func TestAccSpotinstDataIntegration_GCS(t *testing.T) {
+	name := "test-acc-data_integration_gcs_terraform_test"
+	resourceName := createDataIntegrationName(name)
+
+	var di aws.DataIntegration
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testDataIntegrationDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createDataIntegrationTerraform(&DataIntegrationMetadata{
+					name: name,
+				}, testGCSDataIntegrationConfig_Create),
+
+				Check: resource.ComposeTestCheckFunc(
+					testCheckDataIntegrationExists(&di, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "foo-gcs"),
+					resource.TestCheckResourceAttr(resourceName, "vendor", "gcs"),
+					resource.TestCheckResourceAttr(resourceName, "s3.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "gcs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "gcs.0.bucket_name", "terraform-test-do-not-delete"),
+					resource.TestCheckResourceAttr(resourceName, "gcs.0.subdir", "terraform-test-data-integration"),
+					resource.TestCheckResourceAttr(resourceName, "verification_status", "verified"),
+				),
+			},
+		},
+	})
+}
+
+const testGCSDataIntegrationConfig_Create = `
+resource "` + string(commons.DataIntegrationResourceName) + `" "%v" {
+  provider = "%v"
+  name  = "foo-gcs"
+  vendor = "gcs"
+  gcs {
+    bucket_name = "terraform-test-do-not-delete"
+    subdir      = "terraform-test-data-integration"
+  }
+
+  wait_for_verification         = true
+  wait_for_verification_timeout = 300
+}
+`
+
+// endregion
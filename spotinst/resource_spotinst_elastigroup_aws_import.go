@@ -0,0 +1,245 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_import"
+)
+
+func resourceSpotinstElastigroupAWSImport() *schema.Resource {
+	setupElastigroupAWSImportResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstElastigroupAWSImportCreate,
+		ReadContext:   resourceSpotinstElastigroupAWSImportRead,
+		UpdateContext: resourceSpotinstElastigroupAWSImportUpdate,
+		DeleteContext: resourceSpotinstElastigroupAWSImportDelete,
+
+		CustomizeDiff: resourceSpotinstElastigroupAWSImportCustomizeDiff,
+
+		Schema: commons.ElastigroupAWSImportResource.GetSchemaMap(),
+	}
+}
+
+func setupElastigroupAWSImportResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	elastigroup_aws_import.Setup(fieldsMap)
+
+	commons.ElastigroupAWSImportResource = commons.NewElastigroupAWSImportResource(fieldsMap)
+}
+
+// resourceSpotinstElastigroupAWSImportCustomizeDiff makes sure the scale down
+// can wait for the group's capacity, and plans an update while the Auto
+// Scaling Group is still to be scaled down, so that a scale down that failed
+// is retried by the next apply.
+func resourceSpotinstElastigroupAWSImportCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	scaleDown := diff.Get(string(elastigroup_aws_import.ScaleDownASG)).(bool)
+	if scaleDown && diff.NewValueKnown(string(elastigroup_aws_import.WaitForCapacityTimeout)) &&
+		diff.Get(string(elastigroup_aws_import.WaitForCapacityTimeout)).(int) == 0 {
+		return fmt.Errorf("%s must be greater than 0 when %s is set",
+			string(elastigroup_aws_import.WaitForCapacityTimeout), string(elastigroup_aws_import.ScaleDownASG))
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+	if scaleDown &&
+		!diff.Get(string(elastigroup_aws_import.ASGScaledDown)).(bool) {
+		return diff.SetNew(string(elastigroup_aws_import.ASGScaledDown), true)
+	}
+	return nil
+}
+
+// importASG returns the group configuration Spotinst builds from the launch
+// template or launch configuration, subnets, target groups and tags of the
+// Auto Scaling Group. The group is not created.
+func importASG(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (*aws.Group, error) {
+	input := &aws.ImportASGInput{
+		AutoScalingGroupName: spotinst.String(resourceData.Get(string(elastigroup_aws_import.AutoScalingGroupName)).(string)),
+		Region:               spotinst.String(resourceData.Get(string(elastigroup_aws_import.Region)).(string)),
+		DryRun:               spotinst.Bool(true),
+	}
+
+	resp, err := spotinstClient.elastigroup.CloudProviderAWS().ImportASG(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("elastigroup AWS: import failed to read auto scaling group: %s", err)
+	}
+
+	return resp.Group, nil
+}
+
+func resourceSpotinstElastigroupAWSImportCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.ElastigroupAWSImportResource.GetName())
+
+	scaleDown := resourceData.Get(string(elastigroup_aws_import.ScaleDownASG)).(bool)
+
+	importedGroup, err := importASG(ctx, resourceData, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	elastigroup, err := commons.ElastigroupAWSImportResource.OnCreate(importedGroup, resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupId, err := createGroup(resourceData, elastigroup, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(groupId))
+
+	// The group exists from here on, so a failed scale down is only a warning
+	// and is retried by the next apply.
+	var diags diag.Diagnostics
+	if scaleDown {
+		capacity := spotinst.IntValue(elastigroup.Capacity.Target)
+		diags = scaleDownImportedASG(ctx, groupId, capacity, resourceData, meta.(*Client))
+	} else if err := resourceData.Set(string(elastigroup_aws_import.ASGScaledDown), false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Elastigroup imported successfully: %s <===", resourceData.Id())
+	return append(diags, resourceSpotinstElastigroupAWSImportRead(ctx, resourceData, meta)...)
+}
+
+// scaleDownImportedASG scales the Auto Scaling Group to zero once the group
+// has capacity healthy instances, so that the Auto Scaling Group keeps serving
+// until then, and records whether it did in asg_scaled_down.
+func scaleDownImportedASG(ctx context.Context, groupId *string, capacity int, resourceData *schema.ResourceData, spotinstClient *Client) diag.Diagnostics {
+	timeout := resourceData.Get(string(elastigroup_aws_import.WaitForCapacityTimeout)).(int)
+
+	err := awaitReady(groupId, timeout, capacity, spotinstClient)
+	if err == nil {
+		err = scaleDownASG(ctx, resourceData, spotinstClient)
+	}
+	if setErr := resourceData.Set(string(elastigroup_aws_import.ASGScaledDown), err == nil); setErr != nil {
+		return diag.FromErr(setErr)
+	}
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "The auto scaling group was not scaled down, it will be retried by the next apply",
+			Detail:   err.Error(),
+		}}
+	}
+	return nil
+}
+
+func scaleDownASG(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) error {
+	asgName := resourceData.Get(string(elastigroup_aws_import.AutoScalingGroupName)).(string)
+	log.Printf("Scaling down auto scaling group (%s)", asgName)
+
+	input := &aws.ScaleDownASGInput{
+		AutoScalingGroupName: spotinst.String(asgName),
+		Region:               spotinst.String(resourceData.Get(string(elastigroup_aws_import.Region)).(string)),
+	}
+	if _, err := spotinstClient.elastigroup.CloudProviderAWS().ScaleDownASG(ctx, input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to scale down auto scaling group (%s): %s", asgName, err)
+	}
+
+	log.Printf("Successfully scaled down auto scaling group (%s)", asgName)
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSImportRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.ElastigroupAWSImportResource.GetName(), id)
+
+	input := &aws.ReadGroupInput{GroupID: spotinst.String(id)}
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().Read(ctx, input)
+	if err != nil {
+		// If the group was not found, return nil so that we can show
+		// that the group does not exist
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeGroupNotFound {
+					resourceData.SetId("")
+					return nil
+				}
+			}
+		}
+
+		// Some other error, report it.
+		return diag.Errorf("failed to read group: %s", err)
+	}
+
+	// If nothing was found, then return no state.
+	if resp.Group == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.ElastigroupAWSImportResource.OnRead(resp.Group, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Elastigroup read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSImportUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.ElastigroupAWSImportResource.GetName(), id)
+
+	shouldUpdate, elastigroup, err := commons.ElastigroupAWSImportResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if shouldUpdate {
+		elastigroup.SetId(spotinst.String(id))
+
+		if json, err := commons.ToJson(elastigroup); err != nil {
+			return diag.FromErr(err)
+		} else {
+			log.Printf("===> Group update configuration: %s", json)
+		}
+
+		input := &aws.UpdateGroupInput{Group: elastigroup}
+		if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Update(ctx, input); err != nil {
+			return diag.Errorf("[ERROR] Failed to update group [%v]: %v", id, err)
+		}
+	}
+
+	var diags diag.Diagnostics
+	if scaledDown, _ := resourceData.GetChange(string(elastigroup_aws_import.ASGScaledDown)); !scaledDown.(bool) &&
+		resourceData.Get(string(elastigroup_aws_import.ScaleDownASG)).(bool) {
+		capacity := resourceData.Get(string(elastigroup_aws_import.DesiredCapacity)).(int)
+		diags = scaleDownImportedASG(ctx, spotinst.String(id), capacity, resourceData, meta.(*Client))
+	}
+
+	log.Printf("===> Elastigroup updated successfully: %s <===", id)
+	return append(diags, resourceSpotinstElastigroupAWSImportRead(ctx, resourceData, meta)...)
+}
+
+// resourceSpotinstElastigroupAWSImportDelete deletes the group only. An Auto
+// Scaling Group that was scaled down is left with no instances, as the API
+// cannot restore its capacity.
+func resourceSpotinstElastigroupAWSImportDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.ElastigroupAWSImportResource.GetName(), id)
+
+	input := &aws.DeleteGroupInput{GroupID: spotinst.String(id)}
+	if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Delete(ctx, input); err != nil {
+		return diag.Errorf("[ERROR] onDelete() -> Failed to delete group: %s", err)
+	}
+
+	log.Printf("===> Elastigroup deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
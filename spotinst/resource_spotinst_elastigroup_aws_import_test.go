@@ -0,0 +1,122 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createElastigroupAWSImportResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.ElastigroupAWSImportResourceName), name)
+}
+
+func testElastigroupAWSImportDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.ElastigroupAWSImportResourceName) {
+			continue
+		}
+		input := &aws.ReadGroupInput{GroupID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.elastigroup.CloudProviderAWS().Read(context.Background(), input)
+		if err == nil && resp != nil && resp.Group != nil {
+			return fmt.Errorf("group still exists")
+		}
+	}
+	return nil
+}
+
+type ElastigroupAWSImportMetadata struct {
+	provider       string
+	name           string
+	fieldsToAppend string
+}
+
+func createElastigroupAWSImportTerraform(eim *ElastigroupAWSImportMetadata) string {
+	if eim == nil {
+		return ""
+	}
+
+	if eim.provider == "" {
+		eim.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineElastigroupAWSImportConfig,
+		eim.name,
+		eim.provider,
+		eim.fieldsToAppend,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", eim.name, template)
+	return template
+}
+
+func TestAccSpotinstElastigroupAWSImport_Baseline(t *testing.T) {
+	name := "terraform-tests-do-not-delete"
+	resourceName := createElastigroupAWSImportResourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupAWSImportDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupAWSImportTerraform(&ElastigroupAWSImportMetadata{
+					name: name,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "terraform-tests-do-not-delete"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_group_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "asg_scaled_down", "false"),
+				),
+			},
+			{
+				Config: createElastigroupAWSImportTerraform(&ElastigroupAWSImportMetadata{
+					name:           name,
+					fieldsToAppend: testOverridesElastigroupAWSImportConfig,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "terraform-import-override"),
+					resource.TestCheckResourceAttr(resourceName, "spot_percentage", "80"),
+					resource.TestCheckResourceAttr(resourceName, "orientation", "costOriented"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_spot.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "desired_capacity", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineElastigroupAWSImportConfig = `
+resource "` + string(commons.ElastigroupAWSImportResourceName) + `" "%v" {
+  provider = "%v"
+
+  auto_scaling_group_name = "terraform-tests-do-not-delete"
+  region                  = "us-west-2"
+  %v
+}
+`
+
+const testOverridesElastigroupAWSImportConfig = `
+  name                = "terraform-import-override"
+  spot_percentage     = 80
+  orientation         = "costOriented"
+  instance_types_spot = ["m5.large", "m5a.large"]
+  desired_capacity    = 0
+  min_size            = 0
+`
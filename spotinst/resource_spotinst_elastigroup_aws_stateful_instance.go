@@ -0,0 +1,325 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_stateful_instance"
+)
+
+func resourceSpotinstElastigroupAWSStatefulInstance() *schema.Resource {
+	setupElastigroupAWSStatefulInstanceResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstElastigroupAWSStatefulInstanceCreate,
+		ReadContext:   resourceSpotinstElastigroupAWSStatefulInstanceRead,
+		UpdateContext: resourceSpotinstElastigroupAWSStatefulInstanceUpdate,
+		DeleteContext: resourceSpotinstElastigroupAWSStatefulInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importElastigroupAWSStatefulInstance,
+		},
+		Schema: commons.ElastigroupAWSStatefulInstanceResource.GetSchemaMap(),
+	}
+}
+
+func setupElastigroupAWSStatefulInstanceResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	elastigroup_aws_stateful_instance.Setup(fieldsMap)
+
+	commons.ElastigroupAWSStatefulInstanceResource = commons.NewElastigroupAWSStatefulInstanceResource(fieldsMap)
+}
+
+// importElastigroupAWSStatefulInstance imports a stateful instance using an
+// ID of the form `<group_id>:<stateful_instance_id>`.
+func importElastigroupAWSStatefulInstance(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(resourceData.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <group_id>:<stateful_instance_id>", resourceData.Id())
+	}
+
+	if err := resourceData.Set(string(elastigroup_aws_stateful_instance.GroupID), parts[0]); err != nil {
+		return nil, err
+	}
+	if err := resourceData.Set(string(elastigroup_aws_stateful_instance.StatefulInstanceID), parts[1]); err != nil {
+		return nil, err
+	}
+	if err := resourceData.Set(string(elastigroup_aws_stateful_instance.WaitForStateTimeout), 900); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+func resourceSpotinstElastigroupAWSStatefulInstanceCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.ElastigroupAWSStatefulInstanceResource.GetName())
+
+	statefulInstance, err := commons.ElastigroupAWSStatefulInstanceResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupID := spotinst.StringValue(statefulInstance.GroupID)
+	statefulInstanceID := spotinst.StringValue(statefulInstance.StatefulInstanceID)
+	svc := meta.(*Client).elastigroup.CloudProviderAWS()
+
+	current, err := readStatefulInstance(ctx, svc, groupID, statefulInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if current == nil {
+		return diag.Errorf("[ERROR] Stateful instance (%s) does not exist in group (%s)", statefulInstanceID, groupID)
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s:%s", groupID, statefulInstanceID))
+
+	state := resourceData.Get(string(elastigroup_aws_stateful_instance.State)).(string)
+	timeout := resourceData.Get(string(elastigroup_aws_stateful_instance.WaitForStateTimeout)).(int)
+	if err := reconcileStatefulInstance(ctx, svc, groupID, statefulInstanceID, state, false, timeout); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Stateful instance managed successfully: %s <===", resourceData.Id())
+	return resourceSpotinstElastigroupAWSStatefulInstanceRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSStatefulInstanceRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.ElastigroupAWSStatefulInstanceResource.GetName(), id)
+
+	groupID := resourceData.Get(string(elastigroup_aws_stateful_instance.GroupID)).(string)
+	statefulInstanceID := resourceData.Get(string(elastigroup_aws_stateful_instance.StatefulInstanceID)).(string)
+
+	svc := meta.(*Client).elastigroup.CloudProviderAWS()
+	current, err := readStatefulInstance(ctx, svc, groupID, statefulInstanceID)
+	if err != nil {
+		// If the group was not found, return nil so that we can show
+		// that the stateful instance does not exist
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeGroupNotFound {
+					resourceData.SetId("")
+					return nil
+				}
+			}
+		}
+
+		// Some other error, report it.
+		return diag.FromErr(err)
+	}
+
+	// A deallocated instance is eventually removed from the group, which is
+	// the desired state rather than a drift.
+	state := resourceData.Get(string(elastigroup_aws_stateful_instance.State)).(string)
+	if current == nil && state != elastigroup_aws_stateful_instance.StateDeallocated {
+		resourceData.SetId("")
+		return nil
+	}
+	statefulInstance := commons.NewElastigroupAWSStatefulInstance(groupID, statefulInstanceID, current)
+	if err := commons.ElastigroupAWSStatefulInstanceResource.OnRead(statefulInstance, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Stateful instance read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSStatefulInstanceUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.ElastigroupAWSStatefulInstanceResource.GetName(), id)
+
+	stateChanged := resourceData.HasChange(string(elastigroup_aws_stateful_instance.State))
+	recycle := resourceData.HasChange(string(elastigroup_aws_stateful_instance.RecycleTriggers))
+
+	if stateChanged || recycle {
+		oldState, newState := resourceData.GetChange(string(elastigroup_aws_stateful_instance.State))
+		if oldState.(string) == elastigroup_aws_stateful_instance.StateDeallocated {
+			return diag.Errorf("[ERROR] Stateful instance (%s) is deallocated and cannot be changed to %s",
+				id, newState.(string))
+		}
+
+		svc := meta.(*Client).elastigroup.CloudProviderAWS()
+		groupID := resourceData.Get(string(elastigroup_aws_stateful_instance.GroupID)).(string)
+		statefulInstanceID := resourceData.Get(string(elastigroup_aws_stateful_instance.StatefulInstanceID)).(string)
+		timeout := resourceData.Get(string(elastigroup_aws_stateful_instance.WaitForStateTimeout)).(int)
+
+		if err := reconcileStatefulInstance(ctx, svc, groupID, statefulInstanceID, newState.(string), recycle, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("===> Stateful instance updated successfully: %s <===", id)
+	return resourceSpotinstElastigroupAWSStatefulInstanceRead(ctx, resourceData, meta)
+}
+
+// resourceSpotinstElastigroupAWSStatefulInstanceDelete leaves the instance in
+// its current state; it only stops being managed by Terraform.
+func resourceSpotinstElastigroupAWSStatefulInstanceDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.ElastigroupAWSStatefulInstanceResource.GetName(), id)
+
+	resourceData.SetId("")
+	return nil
+}
+
+// readStatefulInstance returns the stateful instance of the group, or nil if
+// the group has no such instance.
+func readStatefulInstance(ctx context.Context, svc aws.Service, groupID, statefulInstanceID string) (*aws.StatefulInstance, error) {
+	statefulInstances, err := listStatefulInstances(ctx, svc, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, statefulInstance := range statefulInstances {
+		if spotinst.StringValue(statefulInstance.StatefulInstanceID) == statefulInstanceID {
+			return statefulInstance, nil
+		}
+	}
+	return nil, nil
+}
+
+func listStatefulInstances(ctx context.Context, svc aws.Service, groupID string) ([]*aws.StatefulInstance, error) {
+	input := &aws.ListStatefulInstancesInput{GroupID: spotinst.String(groupID)}
+	resp, err := svc.ListStatefulInstances(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return resp.StatefulInstances, nil
+}
+
+// reconcileStatefulInstance brings the stateful instance to the desired state.
+// A recycle requires the instance to be running, so a paused instance is
+// resumed first and paused again afterwards if needed.
+func reconcileStatefulInstance(ctx context.Context, svc aws.Service, groupID, statefulInstanceID, desired string, recycle bool, timeout int) error {
+	current, err := awaitStatefulInstanceState(ctx, svc, groupID, statefulInstanceID, "", "", timeout)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		if desired == elastigroup_aws_stateful_instance.StateDeallocated {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Stateful instance (%s) does not exist in group (%s)", statefulInstanceID, groupID)
+	}
+
+	state := strings.ToUpper(spotinst.StringValue(current.State))
+
+	if desired == elastigroup_aws_stateful_instance.StateDeallocated {
+		if state == elastigroup_aws_stateful_instance.StatusDeallocated {
+			return nil
+		}
+		if err := deallocateStatefulInstance(ctx, svc, groupID, statefulInstanceID); err != nil {
+			return err
+		}
+		_, err := awaitStatefulInstanceState(ctx, svc, groupID, statefulInstanceID,
+			elastigroup_aws_stateful_instance.StatusDeallocated, "", timeout)
+		return err
+	}
+
+	if recycle {
+		if state == elastigroup_aws_stateful_instance.StatusPaused {
+			if err := resumeStatefulInstance(ctx, svc, groupID, statefulInstanceID); err != nil {
+				return err
+			}
+			if current, err = awaitStatefulInstanceState(ctx, svc, groupID, statefulInstanceID,
+				elastigroup_aws_stateful_instance.StatusActive, "", timeout); err != nil {
+				return err
+			}
+		}
+
+		previousInstanceID := spotinst.StringValue(current.InstanceID)
+		if err := recycleStatefulInstance(ctx, svc, groupID, statefulInstanceID); err != nil {
+			return err
+		}
+		if current, err = awaitStatefulInstanceState(ctx, svc, groupID, statefulInstanceID,
+			elastigroup_aws_stateful_instance.StatusActive, previousInstanceID, timeout); err != nil {
+			return err
+		}
+		state = elastigroup_aws_stateful_instance.StatusActive
+	}
+
+	switch {
+	case desired == elastigroup_aws_stateful_instance.StateActive && state == elastigroup_aws_stateful_instance.StatusPaused:
+		if err := resumeStatefulInstance(ctx, svc, groupID, statefulInstanceID); err != nil {
+			return err
+		}
+		_, err = awaitStatefulInstanceState(ctx, svc, groupID, statefulInstanceID,
+			elastigroup_aws_stateful_instance.StatusActive, "", timeout)
+	case desired == elastigroup_aws_stateful_instance.StatePaused && state == elastigroup_aws_stateful_instance.StatusActive:
+		if err := pauseStatefulInstance(ctx, svc, groupID, statefulInstanceID); err != nil {
+			return err
+		}
+		_, err = awaitStatefulInstanceState(ctx, svc, groupID, statefulInstanceID,
+			elastigroup_aws_stateful_instance.StatusPaused, "", timeout)
+	}
+	return err
+}
+
+// awaitStatefulInstanceState polls the stateful instance until it reaches the
+// target state, or any settled state if target is empty. A recycled instance
+// has only settled once it runs on a new instance, so previousInstanceID, if
+// set, must differ from the current one.
+func awaitStatefulInstanceState(ctx context.Context, svc aws.Service, groupID, statefulInstanceID, target, previousInstanceID string, timeout int) (*aws.StatefulInstance, error) {
+	if timeout == 0 {
+		return readStatefulInstance(ctx, svc, groupID, statefulInstanceID)
+	}
+
+	var statefulInstance *aws.StatefulInstance
+	description := fmt.Sprintf("stateful instance (%s) to settle", statefulInstanceID)
+	err := commons.AwaitState(ctx, description, timeout, func() (string, bool, error) {
+		current, err := readStatefulInstance(ctx, svc, groupID, statefulInstanceID)
+		if err != nil {
+			return "", false, err
+		}
+		statefulInstance = current
+
+		// Deallocated instances are removed from the group.
+		if current == nil {
+			if target == "" || target == elastigroup_aws_stateful_instance.StatusDeallocated {
+				return elastigroup_aws_stateful_instance.StatusDeallocated, true, nil
+			}
+			return "", false, fmt.Errorf("stateful instance (%s) no longer exists", statefulInstanceID)
+		}
+
+		state := strings.ToUpper(spotinst.StringValue(current.State))
+		instanceID := spotinst.StringValue(current.InstanceID)
+
+		switch {
+		case state == elastigroup_aws_stateful_instance.StatusError:
+			return state, false, fmt.Errorf("stateful instance (%s) is in the %s state", statefulInstanceID, state)
+		case target == "":
+			return state, isStatefulInstanceSettled(state), nil
+		default:
+			return state, state == target && (previousInstanceID == "" || instanceID != previousInstanceID), nil
+		}
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Stateful instance (%s) did not settle: %s", statefulInstanceID, err)
+	}
+
+	return statefulInstance, nil
+}
+
+func isStatefulInstanceSettled(state string) bool {
+	switch state {
+	case elastigroup_aws_stateful_instance.StatusActive,
+		elastigroup_aws_stateful_instance.StatusPaused,
+		elastigroup_aws_stateful_instance.StatusDeallocated:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createStatefulInstanceResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.ElastigroupAWSStatefulInstanceResourceName), name)
+}
+
+func testCheckStatefulInstanceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		statefulInstance, err := readStatefulInstance(context.Background(),
+			client.elastigroup.CloudProviderAWS(),
+			rs.Primary.Attributes["group_id"],
+			rs.Primary.Attributes["stateful_instance_id"])
+		if err != nil {
+			return err
+		}
+		if statefulInstance == nil {
+			return fmt.Errorf("stateful instance not found: %+v", rs.Primary.Attributes)
+		}
+		return nil
+	}
+}
+
+type StatefulInstanceMetadata struct {
+	provider       string
+	name           string
+	fieldsToAppend string
+}
+
+func createStatefulInstanceTerraform(sim *StatefulInstanceMetadata) string {
+	if sim == nil {
+		return ""
+	}
+
+	if sim.provider == "" {
+		sim.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineStatefulInstanceConfig,
+		sim.name,
+		sim.provider,
+		sim.fieldsToAppend,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", sim.name, template)
+	return template
+}
+
+func TestAccSpotinstElastigroupAWSStatefulInstance_Baseline(t *testing.T) {
+	name := "stateful-instance-baseline"
+	resourceName := createStatefulInstanceResourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createStatefulInstanceTerraform(&StatefulInstanceMetadata{
+					name:           name,
+					fieldsToAppend: `state = "active"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckStatefulInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestMatchResourceAttr(resourceName, "instance_id", regexp.MustCompile(`^i-`)),
+				),
+			},
+			{
+				Config: createStatefulInstanceTerraform(&StatefulInstanceMetadata{
+					name:           name,
+					fieldsToAppend: `state = "paused"`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckStatefulInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "paused"),
+					resource.TestCheckResourceAttr(resourceName, "status", "PAUSED"),
+				),
+			},
+			{
+				Config: createStatefulInstanceTerraform(&StatefulInstanceMetadata{
+					name:           name,
+					fieldsToAppend: testRecycleStatefulInstanceConfig,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckStatefulInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "recycle_triggers.version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"recycle_triggers"},
+			},
+		},
+	})
+}
+
+const testBaselineStatefulInstanceConfig = `
+resource "` + string(commons.ElastigroupAWSStatefulInstanceResourceName) + `" "%v" {
+  provider = "%v"
+
+  group_id             = "sig-9f6d7870"
+  stateful_instance_id = "ssi-b1f2c3d4"
+  %v
+}
+`
+
+const testRecycleStatefulInstanceConfig = `
+  state = "active"
+
+  recycle_triggers = {
+    version = "2"
+  }
+`
@@ -31,6 +31,8 @@ func resourceSpotinstHealthCheck() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceSpotinstHealthCheckCustomizeDiff,
+
 		Schema: commons.HealthCheckResource.GetSchemaMap(),
 	}
 }
@@ -42,6 +44,41 @@ func setupHealthCheckResource() {
 	commons.HealthCheckResource = commons.NewHealthCheckResource(fieldsMap)
 }
 
+// resourceSpotinstHealthCheckCustomizeDiff checks that the settings of `check`
+// are supported by its protocol. Unknown protocols, and values not known until
+// apply, are left to the API.
+func resourceSpotinstHealthCheckCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(string(health_check.Check)) {
+		return nil
+	}
+
+	list, ok := diff.Get(string(health_check.Check)).([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+
+	protocol := strings.ToLower(m[string(health_check.Protocol)].(string))
+	isHTTP := protocol == health_check.ProtocolHTTP || protocol == health_check.ProtocolHTTPS
+	hasEndpoint := m[string(health_check.Endpoint)].(string) != "" || m[string(health_check.EndPoint)].(string) != ""
+
+	// An http or https check without an endpoint has always been accepted,
+	// so only the options of the new protocols are rejected here.
+	if protocol == health_check.ProtocolTCP && hasEndpoint {
+		return fmt.Errorf("%s: %s is not supported for the %s protocol",
+			health_check.Check, health_check.Endpoint, protocol)
+	}
+	if v, ok := m[string(health_check.ExpectedStatusCodes)].(*schema.Set); ok && v.Len() > 0 && !isHTTP {
+		return fmt.Errorf("%s: %s is only supported for the %s and %s protocols",
+			health_check.Check, health_check.ExpectedStatusCodes, health_check.ProtocolHTTP, health_check.ProtocolHTTPS)
+	}
+	if v, ok := m[string(health_check.GRPCService)].(string); ok && v != "" && protocol != health_check.ProtocolGRPC {
+		return fmt.Errorf("%s: %s is only supported for the %s protocol",
+			health_check.Check, health_check.GRPCService, health_check.ProtocolGRPC)
+	}
+	return nil
+}
+
 const ErrCodeHealthCheckNotFound = "HEALTH_CHECK_DOESNT_EXIST"
 
 func resourceSpotinstHealthCheckRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -74,10 +111,72 @@ func resourceSpotinstHealthCheckRead(ctx context.Context, resourceData *schema.R
 	if err := commons.HealthCheckResource.OnRead(HealthCheckResponse, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	if err := readHealthCheckStatus(ctx, resourceData, meta.(*Client)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("===> HealthCheck read successfully: %s <===", resourceId)
 	return nil
 }
 
+// readHealthCheckStatus sets the result of the latest check of every instance
+// of the checked resource. The status is UNKNOWN until all of them were checked.
+func readHealthCheckStatus(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) error {
+	healthCheckId := resourceData.Id()
+	status := health_check.StatusUnknown
+	healthy, unhealthy := 0, 0
+	var instanceStatuses []interface{} = nil
+
+	input := &healthcheck.ReadHealthCheckStatusInput{HealthCheckID: spotinst.String(healthCheckId)}
+	resp, err := spotinstClient.healthCheck.ReadStatus(ctx, input)
+	if err != nil {
+		// The configuration was read, so a missing status should not fail the plan.
+		log.Printf("[WARN] failed to read status of HealthCheck [%v]: %v", healthCheckId, err)
+	} else {
+		unknown := 0
+		for _, instanceStatus := range resp.Statuses {
+			value := strings.ToUpper(spotinst.StringValue(instanceStatus.Status))
+			switch value {
+			case health_check.StatusHealthy:
+				healthy++
+			case health_check.StatusUnhealthy:
+				unhealthy++
+			default:
+				value = health_check.StatusUnknown
+				unknown++
+			}
+
+			instanceStatuses = append(instanceStatuses, map[string]interface{}{
+				string(health_check.InstanceID): spotinst.StringValue(instanceStatus.InstanceID),
+				string(health_check.Status):     value,
+				string(health_check.Reason):     spotinst.StringValue(instanceStatus.Reason),
+			})
+		}
+
+		switch {
+		case unhealthy > 0:
+			status = health_check.StatusUnhealthy
+		case healthy > 0 && unknown == 0:
+			status = health_check.StatusHealthy
+		}
+	}
+
+	if err := resourceData.Set(string(health_check.Status), status); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(health_check.Status), err)
+	}
+	if err := resourceData.Set(string(health_check.HealthyCount), healthy); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(health_check.HealthyCount), err)
+	}
+	if err := resourceData.Set(string(health_check.UnhealthyCount), unhealthy); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(health_check.UnhealthyCount), err)
+	}
+	if err := resourceData.Set(string(health_check.InstanceStatuses), instanceStatuses); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(health_check.InstanceStatuses), err)
+	}
+	return nil
+}
+
 func resourceSpotinstHealthCheckCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf(string(commons.ResourceOnCreate), commons.HealthCheckResource.GetName())
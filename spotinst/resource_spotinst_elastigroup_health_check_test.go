@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -143,6 +144,8 @@ func TestAccSpotinstHealthCheckBaseline(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "check.0.protocol", "http"),
 					resource.TestCheckResourceAttr(resourceName, "check.0.timeout", "12"),
 					resource.TestCheckResourceAttr(resourceName, "check.0.unhealthy", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttrSet(resourceName, "healthy_count"),
 				),
 			},
 			{
@@ -210,3 +213,124 @@ resource "` + string(commons.HealthCheckResourceName) + `" "%v" {
 `
 
 // endregion
+
+// region HealthCheck: Protocols
+func TestAccSpotinstHealthCheck_Protocols(t *testing.T) {
+	name := "test-acc-health_check_protocols"
+	resourceName := createHealthCheckResourceName(name)
+
+	var healthCheck healthcheck.HealthCheck
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testHealthCheckDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createHealthCheckProtocolTerraform(name, testHTTPSHealthCheckConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHealthCheckExists(&healthCheck, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check.0.protocol", "https"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.expected_status_codes.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "check.0.expected_status_codes.*", "204"),
+				),
+			},
+			{
+				Config: createHealthCheckProtocolTerraform(name, testTCPHealthCheckConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHealthCheckExists(&healthCheck, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.expected_status_codes.#", "0"),
+				),
+			},
+			{
+				Config: createHealthCheckProtocolTerraform(name, testGRPCHealthCheckConfig),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHealthCheckExists(&healthCheck, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check.0.protocol", "grpc"),
+					resource.TestCheckResourceAttr(resourceName, "check.0.grpc_service", "app.v1.Health"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config:      createHealthCheckProtocolTerraform(name, testInvalidHealthCheckConfig),
+				ExpectError: regexp.MustCompile("expected_status_codes is only supported"),
+			},
+		},
+	})
+}
+
+func createHealthCheckProtocolTerraform(name, check string) string {
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testProtocolHealthCheckConfig, name, check)
+
+	log.Printf("Terraform [%v] template:\n%v", name, template)
+	return template
+}
+
+const testProtocolHealthCheckConfig = `
+resource "` + string(commons.HealthCheckResourceName) + `" "%v" {
+  provider = "aws"
+  resource_id = "sig-9f6d7870"
+  name = "test-acc-health_check_protocols"
+  proxy_address = "http://proxy.com"
+  proxy_port = "80"
+  %v
+}
+`
+
+const testHTTPSHealthCheckConfig = `
+  check {
+    protocol = "https"
+    port = "443"
+    endpoint = "https://endpoint.com/healthz"
+    interval = "10"
+    timeout = "5"
+    unhealthy  = "2"
+    healthy = "2"
+    expected_status_codes = [200, 204]
+  }
+`
+
+const testTCPHealthCheckConfig = `
+  check {
+    protocol = "tcp"
+    port = "5432"
+    interval = "10"
+    timeout = "5"
+    unhealthy  = "2"
+    healthy = "2"
+  }
+`
+
+const testGRPCHealthCheckConfig = `
+  check {
+    protocol = "grpc"
+    port = "50051"
+    interval = "10"
+    timeout = "5"
+    unhealthy  = "2"
+    healthy = "2"
+    grpc_service = "app.v1.Health"
+  }
+`
+
+const testInvalidHealthCheckConfig = `
+  check {
+    protocol = "tcp"
+    port = "5432"
+    interval = "10"
+    timeout = "5"
+    unhealthy  = "2"
+    healthy = "2"
+    expected_status_codes = [200]
+  }
+`
+
+// endregion
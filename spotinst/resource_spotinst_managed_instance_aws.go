@@ -40,10 +40,28 @@ func resourceSpotinstMangedInstanceAWS() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceSpotinstManagedInstanceAWSCustomizeDiff,
+
 		Schema: commons.ManagedInstanceResource.GetSchemaMap(),
 	}
 }
 
+// resourceSpotinstManagedInstanceAWSCustomizeDiff requires `image_id` unless
+// the instance is created by `import_instance`, which builds the image itself.
+func resourceSpotinstManagedInstanceAWSCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk(string(managed_instance_aws.ImportInstance)); ok {
+		return nil
+	}
+	imageID := string(managed_instance_aws_compute_launchspecification.ImageID)
+	if !diff.NewValueKnown(imageID) {
+		return nil
+	}
+	if v, ok := diff.Get(imageID).(string); !ok || v == "" {
+		return fmt.Errorf("%s is required unless %s is set", imageID, managed_instance_aws.ImportInstance)
+	}
+	return nil
+}
+
 func setupMangedInstanceResource() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 
@@ -96,6 +114,11 @@ func resourceSpotinstManagedInstanceAWSRead(ctx context.Context, resourceData *s
 	if err := commons.ManagedInstanceResource.OnRead(managedInstanceResponse, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	if err := readManagedInstanceStatus(ctx, resourceData, meta.(*Client).managedInstance.CloudProviderAWS()); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("===> ManagedInstance read successfully: %s <===", id)
 	return nil
 }
@@ -109,13 +132,26 @@ func resourceSpotinstManagedInstanceAWSCreate(ctx context.Context, resourceData
 		return diag.FromErr(err)
 	}
 
-	ManagedInstanceId, err := createManagedInstance(resourceData, mangedInstance, meta.(*Client))
+	var ManagedInstanceId *string
+	if v, ok := resourceData.GetOk(string(managed_instance_aws.ImportInstance)); ok {
+		ManagedInstanceId, err = importManagedInstance(ctx, v, mangedInstance, meta.(*Client))
+	} else {
+		ManagedInstanceId, err = createManagedInstance(resourceData, mangedInstance, meta.(*Client))
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	resourceData.SetId(spotinst.StringValue(ManagedInstanceId))
 
+	if wait, ok := resourceData.GetOk(string(managed_instance_aws.WaitForState)); ok && wait.(bool) {
+		timeout := resourceData.Get(string(managed_instance_aws.WaitForStateTimeout)).(int)
+		svc := meta.(*Client).managedInstance.CloudProviderAWS()
+		if err := awaitManagedInstanceState(ctx, svc, resourceData.Id(), managed_instance_aws.StatusActive, "", timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Printf("===> ManagedInstance created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstManagedInstanceAWSRead(ctx, resourceData, meta)
@@ -159,6 +195,32 @@ func createManagedInstance(resourceData *schema.ResourceData, mangedInstance *aw
 	return resp.ManagedInstance.ID, nil
 }
 
+// importManagedInstance creates a managed instance from snapshots of the root
+// and data volumes of an existing EC2 instance, and a copy of its network
+// interface. The original instance is only stopped if requested.
+func importManagedInstance(ctx context.Context, data interface{}, mangedInstance *aws.ManagedInstance, spotinstClient *Client) (*string, error) {
+	m := data.([]interface{})[0].(map[string]interface{})
+	originalInstanceID := m[string(managed_instance_aws.OriginalInstanceID)].(string)
+	stopOriginalInstance := m[string(managed_instance_aws.StopOriginalInstance)].(bool)
+
+	input := &aws.ImportManagedInstanceInput{
+		OriginalInstanceID:         spotinst.String(originalInstanceID),
+		ShouldStopOriginalInstance: spotinst.Bool(stopOriginalInstance),
+		ManagedInstance:            mangedInstance,
+	}
+	if json, err := commons.ToJson(input); err != nil {
+		return nil, err
+	} else {
+		log.Printf("===> ManagedInstance import configuration: %s", json)
+	}
+
+	resp, err := spotinstClient.managedInstance.CloudProviderAWS().Import(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] failed to import instance (%s) to ManagedInstance: %s", originalInstanceID, err)
+	}
+	return resp.ManagedInstance.ID, nil
+}
+
 func resourceSpotinstManagedInstanceAWSUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate),
@@ -171,7 +233,7 @@ func resourceSpotinstManagedInstanceAWSUpdate(ctx context.Context, resourceData
 
 	if shouldUpdate {
 		managedInstance.SetId(spotinst.String(id))
-		if err := updateAWSManagedInstance(managedInstance, resourceData, meta); err != nil {
+		if err := updateAWSManagedInstance(ctx, managedInstance, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -180,7 +242,7 @@ func resourceSpotinstManagedInstanceAWSUpdate(ctx context.Context, resourceData
 	return resourceSpotinstManagedInstanceAWSRead(ctx, resourceData, meta)
 }
 
-func updateAWSManagedInstance(managedInstance *aws.ManagedInstance, resourceData *schema.ResourceData, meta interface{}) error {
+func updateAWSManagedInstance(ctx context.Context, managedInstance *aws.ManagedInstance, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &aws.UpdateManagedInstanceInput{
 		ManagedInstance: managedInstance,
 	}
@@ -188,25 +250,41 @@ func updateAWSManagedInstance(managedInstance *aws.ManagedInstance, resourceData
 	if instanceActions, exists := resourceData.GetOk(string(managed_instance_aws.ManagedInstanceAction)); exists {
 		actionList := instanceActions.([]interface{})
 
-		ctx := context.TODO()
 		svc := meta.(*Client).managedInstance.CloudProviderAWS()
+		wait := resourceData.Get(string(managed_instance_aws.WaitForState)).(bool)
+		timeout := resourceData.Get(string(managed_instance_aws.WaitForStateTimeout)).(int)
 
 		for _, action := range actionList {
 			var (
-				actionMap  = action.(map[string]interface{})
-				actionType = actionMap[string(managed_instance_aws.ActionType)].(string)
-				err        error
+				actionMap          = action.(map[string]interface{})
+				actionType         = actionMap[string(managed_instance_aws.ActionType)].(string)
+				targetState        string
+				previousInstanceID string
+				err                error
 			)
 			switch strings.ToLower(actionType) {
 			case "pause":
+				targetState = managed_instance_aws.StatusPaused
 				err = pauseManagedInstance(ctx, svc, resourceData.Id())
 			case "resume":
+				targetState = managed_instance_aws.StatusActive
 				err = resumeManagedInstance(ctx, svc, resourceData.Id())
 			case "recycle":
-				err = recycleManagedInstance(ctx, svc, resourceData.Id())
+				// The instance is ACTIVE before and after the recycle, so the
+				// wait is over once it is ACTIVE with a new EC2 instance.
+				targetState = managed_instance_aws.StatusActive
+				if wait {
+					previousInstanceID, err = readManagedInstanceEC2InstanceID(ctx, svc, resourceData.Id())
+				}
+				if err == nil {
+					err = recycleManagedInstance(ctx, svc, resourceData.Id())
+				}
 			default:
 				err = fmt.Errorf("unsupported action %q on managed instance %q", actionType, resourceData.Id())
 			}
+			if err == nil && wait {
+				err = awaitManagedInstanceState(ctx, svc, resourceData.Id(), targetState, previousInstanceID, timeout)
+			}
 			if err != nil {
 				log.Printf("[ERROR] managed instance (%s) action failed with error: %v", resourceData.Id(), err)
 				return err
@@ -220,7 +298,7 @@ func updateAWSManagedInstance(managedInstance *aws.ManagedInstance, resourceData
 		log.Printf("===> ManagedInstance update configuration: %s", json)
 	}
 
-	if _, err := meta.(*Client).managedInstance.CloudProviderAWS().Update(context.Background(), input); err != nil {
+	if _, err := meta.(*Client).managedInstance.CloudProviderAWS().Update(ctx, input); err != nil {
 		return fmt.Errorf("[ERROR] Failed to update managed instance [%v]: %v", resourceData.Id(), err)
 	}
 
@@ -272,6 +350,92 @@ func recycleManagedInstance(ctx context.Context, svc aws.Service, instanceID str
 	return nil
 }
 
+func readManagedInstanceStatusOutput(ctx context.Context, svc aws.Service, instanceID string) (*aws.StatusManagedInstance, error) {
+	input := &aws.StatusManagedInstanceInput{
+		ManagedInstanceID: spotinst.String(instanceID),
+	}
+	resp, err := svc.Status(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read status of managed instance (%s): %v", instanceID, err)
+	}
+	if resp == nil || len(resp.StatusManagedInstance) == 0 {
+		return nil, nil
+	}
+	return resp.StatusManagedInstance[0], nil
+}
+
+// readManagedInstanceEC2InstanceID returns the ID of the current EC2 instance
+// of the managed instance. The wait for a recycle cannot tell the new instance
+// apart without it, so a missing ID is an error.
+func readManagedInstanceEC2InstanceID(ctx context.Context, svc aws.Service, instanceID string) (string, error) {
+	status, err := readManagedInstanceStatusOutput(ctx, svc, instanceID)
+	if err != nil {
+		return "", err
+	}
+	if status == nil || spotinst.StringValue(status.InstanceID) == "" {
+		return "", fmt.Errorf("failed to read the EC2 instance of managed instance (%s) before recycling it", instanceID)
+	}
+	return spotinst.StringValue(status.InstanceID), nil
+}
+
+// readManagedInstanceStatus sets the state of the managed instance and the
+// details of its current EC2 instance, which change on every recycle.
+func readManagedInstanceStatus(ctx context.Context, resourceData *schema.ResourceData, svc aws.Service) error {
+	status, err := readManagedInstanceStatusOutput(ctx, svc, resourceData.Id())
+	if err != nil {
+		// The status only adds the details of the current EC2 instance, so
+		// keep the values of the previous read rather than fail the refresh.
+		log.Printf("[WARN] %v", err)
+		return nil
+	}
+	if status == nil {
+		status = &aws.StatusManagedInstance{}
+	}
+
+	attributes := map[commons.FieldName]*string{
+		managed_instance_aws.Status:            status.Status,
+		managed_instance_aws.InstanceID:        status.InstanceID,
+		managed_instance_aws.InstancePrivateIP: status.PrivateIP,
+		managed_instance_aws.InstancePublicIP:  status.PublicIP,
+		managed_instance_aws.InstanceLifeCycle: status.LifeCycle,
+	}
+	for field, value := range attributes {
+		if err := resourceData.Set(string(field), spotinst.StringValue(value)); err != nil {
+			return fmt.Errorf(string(commons.FailureFieldReadPattern), string(field), err)
+		}
+	}
+	return nil
+}
+
+func awaitManagedInstanceState(ctx context.Context, svc aws.Service, instanceID, targetState, previousInstanceID string, timeout int) error {
+	description := fmt.Sprintf("managed instance (%s) to be %s", instanceID, targetState)
+	err := commons.AwaitState(ctx, description, timeout, func() (string, bool, error) {
+		status, err := readManagedInstanceStatusOutput(ctx, svc, instanceID)
+		if err != nil {
+			// The status endpoint may fail while the instance transitions, keep polling.
+			log.Printf("[WARN] %v", err)
+			return "", false, nil
+		}
+
+		state, currentInstanceID := "", ""
+		if status != nil {
+			state = strings.ToUpper(spotinst.StringValue(status.Status))
+			currentInstanceID = spotinst.StringValue(status.InstanceID)
+		}
+
+		if state == managed_instance_aws.StatusError && targetState != managed_instance_aws.StatusError {
+			return state, false, fmt.Errorf("managed instance (%s) is in the %s state", instanceID, state)
+		}
+		return state, state == targetState && (previousInstanceID == "" || currentInstanceID != previousInstanceID), nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERROR] Managed instance (%s) did not reach the %s state: %s", instanceID, targetState, err)
+	}
+
+	return nil
+}
+
 func resourceSpotinstManagedInstanceAWSDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete),
@@ -288,6 +452,11 @@ func resourceSpotinstManagedInstanceAWSDelete(ctx context.Context, resourceData
 
 func deleteManagedInstance(resourceData *schema.ResourceData, meta interface{}) error {
 	managedInstanceId := resourceData.Id()
+
+	// The network interface of an imported instance may still be attached to
+	// the original instance, which is never touched on delete.
+	_, imported := resourceData.GetOk(string(managed_instance_aws.ImportInstance))
+
 	input := &aws.DeleteManagedInstanceInput{
 		ManagedInstanceID: spotinst.String(managedInstanceId),
 		AMIBackup: &aws.AMIBackup{
@@ -297,7 +466,7 @@ func deleteManagedInstance(resourceData *schema.ResourceData, meta interface{})
 			ShouldDeleteImages:            spotinst.Bool(true),
 			ShouldTerminateInstance:       spotinst.Bool(true),
 			ShouldDeleteVolumes:           spotinst.Bool(true),
-			ShouldDeleteNetworkInterfaces: spotinst.Bool(true),
+			ShouldDeleteNetworkInterfaces: spotinst.Bool(!imported),
 		},
 	}
 	if json, err := commons.ToJson(input); err != nil {
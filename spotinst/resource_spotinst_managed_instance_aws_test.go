@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -950,3 +951,150 @@ const managedInstanceIntegrations_Load_Balancers_EmptyFields = `
 `
 
 // endregion
+
+// region ManagedInstance: State
+func TestAccSpotinstManagedInstanceState(t *testing.T) {
+	name := "test-acc-cluster-managed-instance-state"
+	resourceName := createManagedInstanceAWSResourceName(name)
+
+	var cluster aws.ManagedInstance
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testManagedInstanceAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createManagedInstanceTerraform(&ManagedInstanceConfigMetadata{
+					name:           name,
+					fieldsToAppend: managedInstanceState_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagedInstanceAWSExists(&cluster, resourceName),
+					testCheckManagedInstanceAWSAttributes(&cluster, name),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_private_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_life_cycle"),
+				),
+			},
+			{
+				Config: createManagedInstanceTerraform(&ManagedInstanceConfigMetadata{
+					name:           name,
+					fieldsToAppend: managedInstanceState_Pause,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagedInstanceAWSExists(&cluster, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "PAUSED"),
+				),
+			},
+			{
+				Config: createManagedInstanceTerraform(&ManagedInstanceConfigMetadata{
+					name:           name,
+					fieldsToAppend: managedInstanceState_Resume,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagedInstanceAWSExists(&cluster, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+				),
+			},
+		},
+	})
+}
+
+const managedInstanceState_Create = `
+ wait_for_state         = true
+ wait_for_state_timeout = 1200
+`
+
+const managedInstanceState_Pause = `
+ wait_for_state         = true
+ wait_for_state_timeout = 1200
+
+ managed_instance_action {
+   type = "pause"
+ }
+`
+
+const managedInstanceState_Resume = `
+ wait_for_state         = true
+ wait_for_state_timeout = 1200
+
+ managed_instance_action {
+   type = "resume"
+ }
+`
+
+// endregion
+
+// region ManagedInstance: Import Instance
+func TestAccSpotinstManagedInstanceImportInstance(t *testing.T) {
+	name := "test-acc-cluster-managed-instance-import"
+	resourceName := createManagedInstanceAWSResourceName(name)
+
+	var cluster aws.ManagedInstance
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testManagedInstanceAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(managedInstanceImportInstance_Create, name, name,
+					managedInstanceImportInstance_Block),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckManagedInstanceAWSExists(&cluster, resourceName),
+					testCheckManagedInstanceAWSAttributes(&cluster, name),
+					resource.TestCheckResourceAttr(resourceName, "import_instance.0.original_instance_id", "i-0a1b2c3d4e5f67890"),
+					resource.TestCheckResourceAttr(resourceName, "import_instance.0.stop_original_instance", "true"),
+					resource.TestCheckResourceAttr(resourceName, "image_id", ""),
+				),
+			},
+			{
+				Config: fmt.Sprintf(managedInstanceImportInstance_Create, name, name,
+					managedInstanceImportInstance_Block+`
+  image_id = "ami-082b5a644766e0e6f"
+`),
+				ExpectError: regexp.MustCompile("conflicts with"),
+			},
+			{
+				Config:      fmt.Sprintf(managedInstanceImportInstance_Create, name, name, ""),
+				ExpectError: regexp.MustCompile("image_id is required unless import_instance is set"),
+			},
+		},
+	})
+}
+
+const managedInstanceImportInstance_Create = `
+provider "aws" {
+  token   = "fake"
+  account = "fake"
+}
+
+resource "` + string(commons.ManagedInstanceAWSResourceName) + `" "%v" {
+  provider = "aws"
+  name = "%v"
+  region = "us-west-2"
+  product = "Linux/UNIX"
+  persist_private_ip = "true"
+  persist_block_devices = "true"
+  persist_root_device = "true"
+  block_devices_mode = "reattach"
+  subnet_ids = ["subnet-0faad0b6bb7e99d9f"]
+  instance_types = ["t3.xlarge"]
+  preferred_type = "t3.xlarge"
+  vpc_id = "vpc-9dee6bfa"
+  life_cycle = "spot"
+  %v
+}
+`
+
+const managedInstanceImportInstance_Block = `
+  import_instance {
+    original_instance_id   = "i-0a1b2c3d4e5f67890"
+    stop_original_instance = true
+  }
+`
+
+// endregion
@@ -71,6 +71,13 @@ func resourceSpotinstMRScalerAWSCreate(ctx context.Context, resourceData *schema
 
 	resourceData.SetId(spotinst.StringValue(scalerId))
 
+	if wait, ok := resourceData.GetOk(string(mrscaler_aws.WaitForReady)); ok && wait.(bool) {
+		timeout := resourceData.Get(string(mrscaler_aws.WaitForReadyTimeout)).(int)
+		if err := awaitMrScalerClusterReady(ctx, scalerId, timeout, meta.(*Client)); err != nil {
+			return diag.Errorf("[ERROR] Cluster of scaler [%v] is not ready: %s", spotinst.StringValue(scalerId), err)
+		}
+	}
+
 	log.Printf("===> MRScaler created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstMRScalerAWSRead(ctx, resourceData, meta)
@@ -130,8 +137,10 @@ func resourceSpotinstMRScalerAWSRead(ctx context.Context, resourceData *schema.R
 		return nil
 	}
 
-	if exist := resourceData.Get(string(mrscaler_aws.ExposeClusterID)).(bool); exist {
-		if err := exposeMrScalerClusterId(resourceData, meta); err != nil {
+	// The cluster is only read when asked for, as scalers may not have one.
+	if resourceData.Get(string(mrscaler_aws.ExposeClusterID)).(bool) ||
+		resourceData.Get(string(mrscaler_aws.WaitForReady)).(bool) {
+		if err := exposeMrScalerCluster(resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -216,20 +225,73 @@ func deleteScaler(resourceData *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func exposeMrScalerClusterId(resourceData *schema.ResourceData, meta interface{}) error {
+// exposeMrScalerCluster sets the attributes of the EMR cluster of the scaler.
+// The cluster may not exist yet, so failing to read it is only an error when
+// expose_cluster_id is set.
+func exposeMrScalerCluster(resourceData *schema.ResourceData, meta interface{}) error {
 	spotinstClient := meta.(*Client)
+	exposeClusterId := resourceData.Get(string(mrscaler_aws.ExposeClusterID)).(bool)
+
 	input := &mrscaler.ScalerClusterStatusInput{ScalerID: spotinst.String(resourceData.Id())}
 	resp, err := spotinstClient.mrscaler.ReadScalerCluster(context.Background(), input)
-
 	if err != nil {
-		return fmt.Errorf("failed reading cloned cluster id of mr scaler : %s", err)
+		if exposeClusterId {
+			return fmt.Errorf("failed reading cloned cluster id of mr scaler : %s", err)
+		}
+		log.Printf("[WARN] failed reading cluster of mr scaler [%v]: %s", resourceData.Id(), err)
+		return nil
 	}
 
-	if resp.ScalerClusterId != nil {
+	if exposeClusterId && resp.ScalerClusterId != nil {
 		if err = resourceData.Set(string(mrscaler_aws.OutputClusterID), resp.ScalerClusterId); err != nil {
 			return err
 		}
 	}
 
+	groupIds := make(map[string]*string)
+	for _, group := range resp.InstanceGroups {
+		groupType := strings.ToUpper(spotinst.StringValue(group.InstanceGroupType))
+		if _, ok := groupIds[groupType]; !ok {
+			groupIds[groupType] = group.ID
+		}
+	}
+
+	attributes := map[commons.FieldName]*string{
+		mrscaler_aws.ClusterState:          resp.State,
+		mrscaler_aws.MasterPublicDNS:       resp.MasterPublicDNSName,
+		mrscaler_aws.MasterInstanceGroupID: groupIds["MASTER"],
+		mrscaler_aws.CoreInstanceGroupID:   groupIds["CORE"],
+		mrscaler_aws.TaskInstanceGroupID:   groupIds["TASK"],
+	}
+	for field, value := range attributes {
+		if err := resourceData.Set(string(field), spotinst.StringValue(value)); err != nil {
+			return fmt.Errorf(string(commons.FailureFieldReadPattern), string(field), err)
+		}
+	}
+
 	return nil
 }
+
+// awaitMrScalerClusterReady waits for the EMR cluster of the scaler to finish
+// its bootstrap actions, i.e. to reach the RUNNING or WAITING state.
+func awaitMrScalerClusterReady(ctx context.Context, scalerId *string, timeout int, spotinstClient *Client) error {
+	description := fmt.Sprintf("cluster of scaler [%v] to be ready", spotinst.StringValue(scalerId))
+	return commons.AwaitState(ctx, description, timeout, func() (string, bool, error) {
+		input := &mrscaler.ScalerClusterStatusInput{ScalerID: scalerId}
+		resp, err := spotinstClient.mrscaler.ReadScalerCluster(ctx, input)
+		if err != nil {
+			// The cluster is created asynchronously, keep polling until it exists.
+			log.Printf("===> waiting for cluster of scaler [%v] to be created: %s <===", spotinst.StringValue(scalerId), err)
+			return "", false, nil
+		}
+
+		state := strings.ToUpper(spotinst.StringValue(resp.State))
+		switch state {
+		case mrscaler_aws.ClusterStateTerminating, mrscaler_aws.ClusterStateTerminated,
+			mrscaler_aws.ClusterStateTerminatedWithErrors:
+			return state, false, fmt.Errorf("cluster [%v] is %s",
+				spotinst.StringValue(resp.ScalerClusterId), state)
+		}
+		return state, state == mrscaler_aws.ClusterStateRunning || state == mrscaler_aws.ClusterStateWaiting, nil
+	})
+}
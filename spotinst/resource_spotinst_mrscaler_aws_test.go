@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -213,6 +214,9 @@ func TestAccSpotinstNewMRScalerAWS_Baseline(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "task_ebs_block_device.0.volumes_per_instance", "2"),
 					resource.TestCheckResourceAttr(resourceName, "task_ebs_block_device.0.volume_type", "gp2"),
 					resource.TestCheckResourceAttr(resourceName, "task_ebs_block_device.0.size_in_gb", "40"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_ready", "true"),
+					resource.TestMatchResourceAttr(resourceName, "cluster_state", regexp.MustCompile("^(RUNNING|WAITING)$")),
+					resource.TestCheckResourceAttrSet(resourceName, "master_instance_group_id"),
 				),
 			},
 			{
@@ -338,6 +342,7 @@ resource "` + string(commons.MRScalerAWSResourceName) + `" "%v" {
  }
 
  keep_job_flow_alive   = true
+ wait_for_ready        = true
 
 tags {
     key   = "Creator"
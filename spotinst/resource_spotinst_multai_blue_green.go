@@ -0,0 +1,393 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_blue_green"
+)
+
+// multaiTargetStatusHealthy is the status reported for targets that pass the
+// target set health check.
+const multaiTargetStatusHealthy = "HEALTHY"
+
+// multaiBlueGreenRollbackTimeout bounds the rollback of an interrupted rollout,
+// which runs after the rollout's own context is done.
+const multaiBlueGreenRollbackTimeout = 5 * time.Minute
+
+func resourceSpotinstMultaiBlueGreen() *schema.Resource {
+	setupMultaiBlueGreenResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstMultaiBlueGreenCreate,
+		ReadContext:   resourceSpotinstMultaiBlueGreenRead,
+		UpdateContext: resourceSpotinstMultaiBlueGreenUpdate,
+		DeleteContext: resourceSpotinstMultaiBlueGreenDelete,
+
+		CustomizeDiff: resourceSpotinstMultaiBlueGreenCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: commons.MultaiBlueGreenResource.GetSchemaMap(),
+	}
+}
+
+func setupMultaiBlueGreenResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	multai_blue_green.Setup(fieldsMap)
+
+	commons.MultaiBlueGreenResource = commons.NewMultaiBlueGreenResource(fieldsMap)
+}
+
+func resourceSpotinstMultaiBlueGreenCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	blue := diff.Get(string(multai_blue_green.BlueTargetSetID)).(string)
+	green := diff.Get(string(multai_blue_green.GreenTargetSetID)).(string)
+	if blue != "" && blue == green {
+		return fmt.Errorf("%q and %q must be different target sets",
+			multai_blue_green.BlueTargetSetID, multai_blue_green.GreenTargetSetID)
+	}
+	return nil
+}
+
+func resourceSpotinstMultaiBlueGreenCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.MultaiBlueGreenResource.GetName())
+
+	blueGreen, err := commons.MultaiBlueGreenResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := attachBlueGreenTargetSets(ctx, blueGreen, meta.(*Client)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(blueGreen.RoutingRuleID))
+	log.Printf("===> Blue/green created successfully: %s <===", resourceData.Id())
+
+	if err := rolloutBlueGreen(ctx, blueGreen, resourceData, meta.(*Client)); err != nil {
+		// Keep the reached weight and rollout status in state.
+		return append(resourceSpotinstMultaiBlueGreenRead(ctx, resourceData, meta), diag.FromErr(err)...)
+	}
+
+	return resourceSpotinstMultaiBlueGreenRead(ctx, resourceData, meta)
+}
+
+// attachBlueGreenTargetSets adds the blue and green target sets to the routing
+// rule, unless they are already attached to it.
+func attachBlueGreenTargetSets(ctx context.Context, blueGreen *commons.MultaiBlueGreen, spotinstClient *Client) error {
+	input := &multai.ReadRoutingRuleInput{RoutingRuleID: blueGreen.RoutingRuleID}
+	resp, err := spotinstClient.multai.ReadRoutingRule(ctx, input)
+	if err != nil {
+		return fmt.Errorf("[ERROR] failed to read routing rule: %s", err)
+	}
+	if resp.RoutingRule == nil {
+		return fmt.Errorf("[ERROR] routing rule %s does not exist", spotinst.StringValue(blueGreen.RoutingRuleID))
+	}
+
+	routingRule := resp.RoutingRule
+	attached := false
+	for _, targetSetId := range []string{
+		spotinst.StringValue(blueGreen.BlueTargetSetID),
+		spotinst.StringValue(blueGreen.GreenTargetSetID),
+	} {
+		if !containsString(routingRule.TargetSetIDs, targetSetId) {
+			routingRule.TargetSetIDs = append(routingRule.TargetSetIDs, targetSetId)
+			attached = true
+		}
+	}
+	if !attached {
+		return nil
+	}
+
+	if json, err := commons.ToJson(routingRule); err != nil {
+		return err
+	} else {
+		log.Printf("===> Routing Rule update configuration: %s", json)
+	}
+
+	if _, err := spotinstClient.multai.UpdateRoutingRule(ctx, &multai.UpdateRoutingRuleInput{RoutingRule: routingRule}); err != nil {
+		return fmt.Errorf("[ERROR] failed to attach target sets to routing rule [%v]: %v",
+			spotinst.StringValue(blueGreen.RoutingRuleID), err)
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// rolloutBlueGreen shifts weight between the target sets in steps until the
+// green target set receives the desired weight. The health of the target sets
+// that receive traffic is checked after every step; when it drops below the
+// configured threshold, or the resource timeout expires, the rollout stops and,
+// if enabled, the weights are restored to where they were before the rollout.
+func rolloutBlueGreen(ctx context.Context, blueGreen *commons.MultaiBlueGreen, resourceData *schema.ResourceData, spotinstClient *Client) error {
+	start, err := readBlueGreenWeight(ctx, blueGreen, spotinstClient)
+	if err != nil {
+		return err
+	}
+
+	target := spotinst.IntValue(blueGreen.GreenWeight)
+	step := spotinst.IntValue(blueGreen.StepSize)
+	interval := time.Second * time.Duration(spotinst.IntValue(blueGreen.StepInterval))
+
+	current := start
+	for current != target {
+		next := nextBlueGreenWeight(current, target, step)
+		if err := setBlueGreenWeights(ctx, blueGreen, next, spotinstClient); err != nil {
+			setBlueGreenStatus(resourceData, multai_blue_green.StatusFailed, err.Error())
+			return err
+		}
+		current = next
+
+		message := fmt.Sprintf("green target set weight is %d%%, target is %d%%", current, target)
+		log.Printf("===> rolloutBlueGreen() -> %s <===", message)
+		setBlueGreenStatus(resourceData, multai_blue_green.StatusInProgress, message)
+
+		select {
+		case <-ctx.Done():
+			return stopBlueGreenRollout(blueGreen, start, current,
+				fmt.Errorf("rollout interrupted: %s", ctx.Err()), resourceData, spotinstClient)
+		case <-time.After(interval):
+		}
+
+		if healthErr := checkBlueGreenHealth(ctx, blueGreen, current, spotinstClient); healthErr != nil {
+			return stopBlueGreenRollout(blueGreen, start, current, healthErr, resourceData, spotinstClient)
+		}
+	}
+
+	setBlueGreenStatus(resourceData, multai_blue_green.StatusCompleted,
+		fmt.Sprintf("green target set weight is %d%%", current))
+	return nil
+}
+
+// stopBlueGreenRollout ends a rollout that cannot go on. If rollback is
+// enabled, the weights are restored to start. Otherwise they stay at current
+// and the rollout is recorded as failed. The rollback gets its own deadline
+// because the rollout context may already be done.
+func stopBlueGreenRollout(blueGreen *commons.MultaiBlueGreen, start, current int, cause error,
+	resourceData *schema.ResourceData, spotinstClient *Client) error {
+	if !spotinst.BoolValue(blueGreen.RollbackOnFailure) {
+		setBlueGreenStatus(resourceData, multai_blue_green.StatusFailed, cause.Error())
+		return fmt.Errorf("[ERROR] Blue/green rollout stopped at %d%%: %s", current, cause)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), multaiBlueGreenRollbackTimeout)
+	defer cancel()
+
+	log.Printf("===> rolloutBlueGreen() -> rolling back to %d%%: %s <===", start, cause)
+	if err := setBlueGreenWeights(ctx, blueGreen, start, spotinstClient); err != nil {
+		setBlueGreenStatus(resourceData, multai_blue_green.StatusFailed,
+			fmt.Sprintf("%s; rollback failed: %s", cause, err))
+		return fmt.Errorf("[ERROR] Blue/green rollback failed after %s: %s", cause, err)
+	}
+	setBlueGreenStatus(resourceData, multai_blue_green.StatusRolledBack, cause.Error())
+	return fmt.Errorf("[ERROR] Blue/green rollout rolled back to %d%%: %s", start, cause)
+}
+
+func nextBlueGreenWeight(current, target, step int) int {
+	if current < target {
+		if current+step > target {
+			return target
+		}
+		return current + step
+	}
+	if current-step < target {
+		return target
+	}
+	return current - step
+}
+
+func setBlueGreenStatus(resourceData *schema.ResourceData, status, message string) {
+	if err := resourceData.Set(string(multai_blue_green.Status), status); err != nil {
+		log.Printf("[ERROR] failed to set %s: %s", multai_blue_green.Status, err)
+	}
+	if err := resourceData.Set(string(multai_blue_green.Message), message); err != nil {
+		log.Printf("[ERROR] failed to set %s: %s", multai_blue_green.Message, err)
+	}
+}
+
+// readBlueGreenWeight returns the share of traffic, in percent, that the green
+// target set currently receives.
+func readBlueGreenWeight(ctx context.Context, blueGreen *commons.MultaiBlueGreen, spotinstClient *Client) (int, error) {
+	blue, err := readBlueGreenTargetSet(ctx, blueGreen.BlueTargetSetID, spotinstClient)
+	if err != nil {
+		return 0, err
+	}
+	green, err := readBlueGreenTargetSet(ctx, blueGreen.GreenTargetSetID, spotinstClient)
+	if err != nil {
+		return 0, err
+	}
+
+	total := spotinst.IntValue(blue.Weight) + spotinst.IntValue(green.Weight)
+	if total == 0 {
+		return 0, nil
+	}
+	return spotinst.IntValue(green.Weight) * multai_blue_green.TotalWeight / total, nil
+}
+
+func readBlueGreenTargetSet(ctx context.Context, targetSetId *string, spotinstClient *Client) (*multai.TargetSet, error) {
+	input := &multai.ReadTargetSetInput{TargetSetID: targetSetId}
+	resp, err := spotinstClient.multai.ReadTargetSet(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] failed to read target set [%v]: %v", spotinst.StringValue(targetSetId), err)
+	}
+	if resp.TargetSet == nil {
+		return nil, fmt.Errorf("[ERROR] target set %s does not exist", spotinst.StringValue(targetSetId))
+	}
+	return resp.TargetSet, nil
+}
+
+func setBlueGreenWeights(ctx context.Context, blueGreen *commons.MultaiBlueGreen, greenWeight int, spotinstClient *Client) error {
+	weights := map[*string]int{
+		blueGreen.BlueTargetSetID:  multai_blue_green.TotalWeight - greenWeight,
+		blueGreen.GreenTargetSetID: greenWeight,
+	}
+
+	for targetSetId, weight := range weights {
+		targetSet, err := readBlueGreenTargetSet(ctx, targetSetId, spotinstClient)
+		if err != nil {
+			return err
+		}
+		if spotinst.IntValue(targetSet.Weight) == weight {
+			continue
+		}
+		targetSet.SetWeight(spotinst.Int(weight))
+
+		if _, err := spotinstClient.multai.UpdateTargetSet(ctx, &multai.UpdateTargetSetInput{TargetSet: targetSet}); err != nil {
+			return fmt.Errorf("[ERROR] Failed to update target set [%v] weight: %v", spotinst.StringValue(targetSetId), err)
+		}
+	}
+	return nil
+}
+
+// checkBlueGreenHealth returns an error when a target set that receives traffic
+// has less healthy targets than required.
+func checkBlueGreenHealth(ctx context.Context, blueGreen *commons.MultaiBlueGreen, greenWeight int, spotinstClient *Client) error {
+	targetSets := make([]*string, 0, 2)
+	if greenWeight < multai_blue_green.TotalWeight {
+		targetSets = append(targetSets, blueGreen.BlueTargetSetID)
+	}
+	if greenWeight > 0 {
+		targetSets = append(targetSets, blueGreen.GreenTargetSetID)
+	}
+
+	minHealthy := spotinst.IntValue(blueGreen.MinHealthyPercentage)
+	for _, targetSetId := range targetSets {
+		input := &multai.ListTargetsInput{TargetSetID: targetSetId}
+		resp, err := spotinstClient.multai.ListTargets(ctx, input)
+		if err != nil {
+			return fmt.Errorf("failed to list targets of target set %s: %s", spotinst.StringValue(targetSetId), err)
+		}
+
+		healthy := 0
+		for _, target := range resp.Targets {
+			if target.Status != nil && strings.EqualFold(spotinst.StringValue(target.Status.Status), multaiTargetStatusHealthy) {
+				healthy++
+			}
+		}
+
+		total := len(resp.Targets)
+		if total == 0 {
+			if minHealthy > 0 {
+				return fmt.Errorf("target set %s has no targets", spotinst.StringValue(targetSetId))
+			}
+			continue
+		}
+		if percentage := healthy * 100 / total; percentage < minHealthy {
+			return fmt.Errorf("target set %s has %d%% healthy targets (%d/%d), minimum is %d%%",
+				spotinst.StringValue(targetSetId), percentage, healthy, total, minHealthy)
+		}
+	}
+	return nil
+}
+
+func resourceSpotinstMultaiBlueGreenRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.MultaiBlueGreenResource.GetName(), id)
+
+	input := &multai.ReadRoutingRuleInput{RoutingRuleID: spotinst.String(id)}
+	resp, err := meta.(*Client).multai.ReadRoutingRule(ctx, input)
+	if err != nil {
+		return diag.Errorf("failed to read routing rule: %s", err)
+	}
+
+	blueGreen := &commons.MultaiBlueGreen{
+		RoutingRuleID:    spotinst.String(id),
+		BlueTargetSetID:  spotinst.String(resourceData.Get(string(multai_blue_green.BlueTargetSetID)).(string)),
+		GreenTargetSetID: spotinst.String(resourceData.Get(string(multai_blue_green.GreenTargetSetID)).(string)),
+	}
+
+	// If the routing rule or one of the target sets is gone, return no state.
+	if resp.RoutingRule == nil ||
+		!containsString(resp.RoutingRule.TargetSetIDs, spotinst.StringValue(blueGreen.BlueTargetSetID)) ||
+		!containsString(resp.RoutingRule.TargetSetIDs, spotinst.StringValue(blueGreen.GreenTargetSetID)) {
+		resourceData.SetId("")
+		return nil
+	}
+
+	greenWeight, err := readBlueGreenWeight(ctx, blueGreen, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	blueGreen.GreenWeight = spotinst.Int(greenWeight)
+
+	if err := commons.MultaiBlueGreenResource.OnRead(blueGreen, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Blue/green read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstMultaiBlueGreenUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.MultaiBlueGreenResource.GetName(), id)
+
+	shouldUpdate, blueGreen, err := commons.MultaiBlueGreenResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Changes to the step settings only take effect on the next rollout.
+	if shouldUpdate && resourceData.HasChange(string(multai_blue_green.GreenWeight)) {
+		if err := rolloutBlueGreen(ctx, blueGreen, resourceData, meta.(*Client)); err != nil {
+			return append(resourceSpotinstMultaiBlueGreenRead(ctx, resourceData, meta), diag.FromErr(err)...)
+		}
+	}
+
+	log.Printf("===> Blue/green updated successfully: %s <===", id)
+	return resourceSpotinstMultaiBlueGreenRead(ctx, resourceData, meta)
+}
+
+// resourceSpotinstMultaiBlueGreenDelete leaves the routing rule and the target
+// set weights as they are, so destroying the resource does not move traffic.
+func resourceSpotinstMultaiBlueGreenDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.MultaiBlueGreenResource.GetName(), id)
+
+	log.Printf("===> Blue/green deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
@@ -0,0 +1,203 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createMultaiBlueGreenResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.MultaiBlueGreenResourceName), name)
+}
+
+func testAccCheckSpotinstMultaiBlueGreenWeight(resourceName string, greenWeight int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		weights := map[string]int{
+			rs.Primary.Attributes["blue_target_set_id"]:  100 - greenWeight,
+			rs.Primary.Attributes["green_target_set_id"]: greenWeight,
+		}
+		for targetSetId, weight := range weights {
+			input := &multai.ReadTargetSetInput{TargetSetID: spotinst.String(targetSetId)}
+			resp, err := client.multai.ReadTargetSet(context.Background(), input)
+			if err != nil {
+				return err
+			}
+			if got := spotinst.IntValue(resp.TargetSet.Weight); got != weight {
+				return fmt.Errorf("target set %s has weight %d, expected %d", targetSetId, got, weight)
+			}
+		}
+		return nil
+	}
+}
+
+type BlueGreenConfigMetadata struct {
+	provider    string
+	name        string
+	greenWeight int
+}
+
+func createBlueGreenTerraform(bgm *BlueGreenConfigMetadata) string {
+	if bgm == nil {
+		return ""
+	}
+
+	if bgm.provider == "" {
+		bgm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineBlueGreenConfig,
+		bgm.name,
+		bgm.provider,
+		bgm.greenWeight,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", bgm.name, template)
+	return template
+}
+
+func TestAccSpotinstMultaiBlueGreen_Baseline(t *testing.T) {
+	blueGreenName := "blue-green-baseline"
+	resourceName := createMultaiBlueGreenResourceName(blueGreenName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createBlueGreenTerraform(&BlueGreenConfigMetadata{
+					name:        blueGreenName,
+					greenWeight: 0,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpotinstMultaiBlueGreenWeight(resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "green_weight", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+				),
+			},
+			{
+				Config: createBlueGreenTerraform(&BlueGreenConfigMetadata{
+					name:        blueGreenName,
+					greenWeight: 50,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSpotinstMultaiBlueGreenWeight(resourceName, 50),
+					resource.TestCheckResourceAttr(resourceName, "green_weight", "50"),
+					resource.TestCheckResourceAttr(resourceName, "status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineBlueGreenConfig = `
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name = "test-acc-foo"
+
+  connection_timeouts {
+    idle     = 10
+    draining = 10
+  }
+}
+
+resource "spotinst_multai_target_set" "blue" {
+  provider = "aws"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "dp-12345"
+  name          = "test-acc-blue"
+  protocol      = "http"
+  port          = 1338
+  weight        = 100
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3001
+    interval            = 20
+    timeout             = 5
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+  }
+
+  lifecycle {
+    ignore_changes = [weight]
+  }
+}
+
+resource "spotinst_multai_target_set" "green" {
+  provider = "aws"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "dp-12345"
+  name          = "test-acc-green"
+  protocol      = "http"
+  port          = 1338
+  weight        = 0
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3001
+    interval            = 20
+    timeout             = 5
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+  }
+
+  lifecycle {
+    ignore_changes = [weight]
+  }
+}
+
+resource "spotinst_multai_listener" "foo" {
+  provider = "aws"
+  balancer_id = "${spotinst_multai_balancer.foo.id}"
+  protocol    = "http"
+  port        = 1338
+}
+
+resource "spotinst_multai_routing_rule" "foo" {
+  provider = "aws"
+  balancer_id    = "${spotinst_multai_balancer.foo.id}"
+  listener_id    = "${spotinst_multai_listener.foo.id}"
+  route          = "Path(` + "`/bar`" + `)"
+  strategy       = "RANDOM"
+  target_set_ids = [
+    "${spotinst_multai_target_set.blue.id}",
+    "${spotinst_multai_target_set.green.id}",
+  ]
+}
+
+resource "` + string(commons.MultaiBlueGreenResourceName) + `" "%v" {
+  provider = "%v"
+  routing_rule_id     = "${spotinst_multai_routing_rule.foo.id}"
+  blue_target_set_id  = "${spotinst_multai_target_set.blue.id}"
+  green_target_set_id = "${spotinst_multai_target_set.green.id}"
+
+  green_weight           = %v
+  step_size              = 25
+  step_interval          = 5
+  min_healthy_percentage = 0
+}`
@@ -0,0 +1,149 @@
+package spotinst
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_certificate"
+)
+
+func resourceSpotinstMultaiCertificate() *schema.Resource {
+	setupMultaiCertificateResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstMultaiCertificateCreate,
+		ReadContext:   resourceSpotinstMultaiCertificateRead,
+		UpdateContext: resourceSpotinstMultaiCertificateUpdate,
+		DeleteContext: resourceSpotinstMultaiCertificateDelete,
+
+		Schema: commons.MultaiCertificateResource.GetSchemaMap(),
+	}
+}
+
+func setupMultaiCertificateResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	multai_certificate.Setup(fieldsMap)
+
+	commons.MultaiCertificateResource = commons.NewMultaiCertificateResource(fieldsMap)
+}
+
+func resourceSpotinstMultaiCertificateCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.MultaiCertificateResource.GetName())
+
+	certificate, err := commons.MultaiCertificateResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Catch a key that does not belong to the certificate before uploading it.
+	if _, err := tls.X509KeyPair(
+		[]byte(spotinst.StringValue(certificate.CertPEMBlock)),
+		[]byte(spotinst.StringValue(certificate.KeyPEMBlock))); err != nil {
+		return diag.Errorf("[ERROR] invalid certificate and private key pair: %s", err)
+	}
+
+	certificateId, err := createCertificate(certificate, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(certificateId))
+	log.Printf("===> Certificate created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstMultaiCertificateRead(ctx, resourceData, meta)
+}
+
+func createCertificate(certificate *multai.Certificate, spotinstClient *Client) (*string, error) {
+	// The private key is not logged.
+	log.Printf("===> Certificate create configuration: %s", spotinst.StringValue(certificate.Name))
+
+	var resp *multai.CreateCertificateOutput = nil
+	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		input := &multai.CreateCertificateInput{Certificate: certificate}
+		r, err := spotinstClient.multai.CreateCertificate(context.Background(), input)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resp = r
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] failed to create certificate: %s", err)
+	}
+
+	return resp.Certificate.ID, nil
+}
+
+func resourceSpotinstMultaiCertificateRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	certificateId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.MultaiCertificateResource.GetName(), certificateId)
+
+	input := &multai.ReadCertificateInput{CertificateID: spotinst.String(certificateId)}
+	resp, err := meta.(*Client).multai.ReadCertificate(context.Background(), input)
+	if err != nil {
+		return diag.Errorf("failed to read certificate: %s", err)
+	}
+
+	// If nothing was found, return no state
+	certificateResponse := resp.Certificate
+	if certificateResponse == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.MultaiCertificateResource.OnRead(certificateResponse, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Certificate read successfully: %s <===", certificateId)
+	return nil
+}
+
+func resourceSpotinstMultaiCertificateUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	certificateId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.MultaiCertificateResource.GetName(), certificateId)
+
+	shouldUpdate, certificate, err := commons.MultaiCertificateResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if shouldUpdate {
+		certificate.SetId(spotinst.String(certificateId))
+		var input = &multai.UpdateCertificateInput{Certificate: certificate}
+		if _, err := meta.(*Client).multai.UpdateCertificate(context.Background(), input); err != nil {
+			return diag.Errorf("[ERROR] Failed to update certificate [%v]: %v", certificateId, err)
+		}
+	}
+
+	log.Printf("===> Certificate updated successfully: %s <===", certificateId)
+	return resourceSpotinstMultaiCertificateRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstMultaiCertificateDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	certificateId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.MultaiCertificateResource.GetName(), certificateId)
+
+	input := &multai.DeleteCertificateInput{CertificateID: spotinst.String(certificateId)}
+	if _, err := meta.(*Client).multai.DeleteCertificate(context.Background(), input); err != nil {
+		return diag.Errorf("[ERROR] onDelete() -> Failed to delete certificate: %s", err)
+	}
+
+	log.Printf("===> Certificate deleted successfully: %s <===", certificateId)
+	resourceData.SetId("")
+	return nil
+}
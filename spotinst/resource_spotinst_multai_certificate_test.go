@@ -0,0 +1,164 @@
+package spotinst
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createMultaiCertificateResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.MultaiCertificateResourceName), name)
+}
+
+func testAccCheckSpotinstMultaiCertificateDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.MultaiCertificateResourceName) {
+			continue
+		}
+		input := &multai.ReadCertificateInput{
+			CertificateID: spotinst.String(rs.Primary.ID),
+		}
+		resp, err := client.multai.ReadCertificate(context.Background(), input)
+		if err == nil && resp != nil && resp.Certificate != nil {
+			return fmt.Errorf("certificate still exists")
+		}
+	}
+	return nil
+}
+
+// testAccSelfSignedCertificate returns a PEM encoded self-signed certificate
+// for the given DNS names and its private key.
+func testAccSelfSignedCertificate(t *testing.T, dnsNames ...string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(cert), string(keyPem)
+}
+
+func createCertificateTerraform(name, certificateName, cert, key string) string {
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	template += fmt.Sprintf(testBaselineCertificateConfig,
+		name,
+		certificateName,
+		cert,
+		key,
+		name,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", name, template)
+	return template
+}
+
+func TestAccSpotinstMultaiCertificate_Baseline(t *testing.T) {
+	certificateName := "certificate-baseline"
+	resourceName := createMultaiCertificateResourceName(certificateName)
+	cert, key := testAccSelfSignedCertificate(t, "test-acc.example.com", "www.test-acc.example.com")
+	rotatedCert, rotatedKey := testAccSelfSignedCertificate(t, "test-acc.example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testAccCheckSpotinstMultaiCertificateDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createCertificateTerraform(certificateName, "test-acc-cert", cert, key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "test-acc-cert"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.0", "test-acc.example.com"),
+					resource.TestCheckTypeSetElemAttrPair("spotinst_multai_listener.foo", "tls_config.*.certificate_ids.0", resourceName, "id"),
+				),
+			},
+			{
+				Config: createCertificateTerraform(certificateName, "test-acc-cert-rotated", rotatedCert, rotatedKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "test-acc-cert-rotated"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("spotinst_multai_listener.foo", "tls_config.*.certificate_ids.0", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineCertificateConfig = `
+resource "` + string(commons.MultaiCertificateResourceName) + `" "%v" {
+  provider = "aws"
+  name     = "%v"
+
+  certificate_body = <<EOT
+%vEOT
+
+  private_key = <<EOT
+%vEOT
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name = "test-acc-foo"
+}
+
+resource "spotinst_multai_listener" "foo" {
+  provider = "aws"
+  balancer_id = "${spotinst_multai_balancer.foo.id}"
+  protocol    = "https"
+  port        = 443
+
+  tls_config {
+    certificate_ids             = ["${` + string(commons.MultaiCertificateResourceName) + `.%v.id}"]
+    min_version                 = "tls10"
+    max_version                 = "tls12"
+    session_tickets_disabled    = false
+    prefer_server_cipher_suites = true
+    cipher_suites               = ["TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"]
+  }
+}`
@@ -13,6 +13,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/multai"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_certificate"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_listener"
 )
 
@@ -29,6 +30,8 @@ func resourceSpotinstMultaiListener() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceSpotinstMultaiListenerCustomizeDiff,
+
 		Schema: commons.MultaiListenerResource.GetSchemaMap(),
 	}
 }
@@ -41,6 +44,55 @@ func setupMultaiListenerResource() {
 	commons.MultaiListenerResource = commons.NewMultaiListenerResource(fieldsMap)
 }
 
+// resourceSpotinstMultaiListenerCustomizeDiff checks that the certificates set
+// in `tls_config` exist and have not expired. Certificates created in the same
+// apply are not known yet and are checked by the API instead.
+func resourceSpotinstMultaiListenerCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange(string(multai_listener.TLSConfig)) || !diff.NewValueKnown(string(multai_listener.TLSConfig)) {
+		return nil
+	}
+
+	for _, item := range diff.Get(string(multai_listener.TLSConfig)).(*schema.Set).List() {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, id := range m[string(multai_listener.CertificateIDs)].([]interface{}) {
+			certificateId, ok := id.(string)
+			if !ok || certificateId == "" {
+				continue
+			}
+			if err := checkListenerCertificate(ctx, certificateId, meta.(*Client)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkListenerCertificate(ctx context.Context, certificateId string, spotinstClient *Client) error {
+	input := &multai.ReadCertificateInput{CertificateID: spotinst.String(certificateId)}
+	resp, err := spotinstClient.multai.ReadCertificate(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to read certificate %s: %s", certificateId, err)
+	}
+	if resp.Certificate == nil {
+		return fmt.Errorf("certificate %s does not exist", certificateId)
+	}
+	if resp.Certificate.CertPEMBlock == nil {
+		return nil
+	}
+
+	cert, err := multai_certificate.ParseCertificate(spotinst.StringValue(resp.Certificate.CertPEMBlock))
+	if err != nil {
+		return fmt.Errorf("certificate %s: %s", certificateId, err)
+	}
+	if time.Now().After(cert.NotAfter) {
+		return fmt.Errorf("certificate %s expired at %s", certificateId, cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
 func resourceSpotinstMultaiListenerCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf(string(commons.ResourceOnCreate),
 		commons.MultaiListenerResource.GetName())
@@ -0,0 +1,200 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_middleware"
+)
+
+func resourceSpotinstMultaiMiddleware() *schema.Resource {
+	setupMultaiMiddlewareResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstMultaiMiddlewareCreate,
+		ReadContext:   resourceSpotinstMultaiMiddlewareRead,
+		UpdateContext: resourceSpotinstMultaiMiddlewareUpdate,
+		DeleteContext: resourceSpotinstMultaiMiddlewareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: commons.MultaiMiddlewareResource.GetSchemaMap(),
+	}
+}
+
+func setupMultaiMiddlewareResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	multai_middleware.Setup(fieldsMap)
+
+	commons.MultaiMiddlewareResource = commons.NewMultaiMiddlewareResource(fieldsMap)
+}
+
+func resourceSpotinstMultaiMiddlewareCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.MultaiMiddlewareResource.GetName())
+
+	middleware, err := commons.MultaiMiddlewareResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	middlewareId, err := createMiddleware(middleware, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(middlewareId))
+	log.Printf("===> Middleware created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstMultaiMiddlewareRead(ctx, resourceData, meta)
+}
+
+func createMiddleware(middleware *multai.Middleware, spotinstClient *Client) (*string, error) {
+	if json, err := commons.ToJson(middleware); err != nil {
+		return nil, err
+	} else {
+		log.Printf("===> Middleware create configuration: %s", json)
+	}
+
+	var resp *multai.CreateMiddlewareOutput = nil
+	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		input := &multai.CreateMiddlewareInput{Middleware: middleware}
+		r, err := spotinstClient.multai.CreateMiddleware(context.Background(), input)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		resp = r
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] failed to create middleware: %s", err)
+	}
+
+	return resp.Middleware.ID, nil
+}
+
+func resourceSpotinstMultaiMiddlewareRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	middlewareId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.MultaiMiddlewareResource.GetName(), middlewareId)
+
+	input := &multai.ReadMiddlewareInput{MiddlewareID: spotinst.String(middlewareId)}
+	resp, err := meta.(*Client).multai.ReadMiddleware(context.Background(), input)
+	if err != nil {
+		return diag.Errorf("failed to read middleware: %s", err)
+	}
+
+	// If nothing was found, return no state
+	middlewareResponse := resp.Middleware
+	if middlewareResponse == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.MultaiMiddlewareResource.OnRead(middlewareResponse, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Middleware read successfully: %s <===", middlewareId)
+	return nil
+}
+
+func resourceSpotinstMultaiMiddlewareUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	middlewareId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.MultaiMiddlewareResource.GetName(), middlewareId)
+
+	shouldUpdate, middleware, err := commons.MultaiMiddlewareResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if shouldUpdate {
+		middleware.SetId(spotinst.String(middlewareId))
+		if err := updateMiddleware(middleware, resourceData, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("===> Middleware updated successfully: %s <===", middlewareId)
+	return resourceSpotinstMultaiMiddlewareRead(ctx, resourceData, meta)
+}
+
+func updateMiddleware(middleware *multai.Middleware, resourceData *schema.ResourceData, meta interface{}) error {
+	var input = &multai.UpdateMiddlewareInput{Middleware: middleware}
+	middlewareId := resourceData.Id()
+
+	if json, err := commons.ToJson(middleware); err != nil {
+		return err
+	} else {
+		log.Printf("===> Middleware update configuration: %s", json)
+	}
+
+	if _, err := meta.(*Client).multai.UpdateMiddleware(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to update middleware [%v]: %v", middlewareId, err)
+	}
+
+	return nil
+}
+
+func resourceSpotinstMultaiMiddlewareDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	middlewareId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.MultaiMiddlewareResource.GetName(), middlewareId)
+
+	if err := deleteMiddleware(resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err := awaitMiddlewareDeleted(spotinst.String(middlewareId), meta.(*Client))
+	if err != nil {
+		return diag.Errorf("[ERROR] Timed out when waiting for the middleware to delete. error: %v", err)
+	}
+
+	log.Printf("===> Middleware deleted successfully: %s <===", resourceData.Id())
+	resourceData.SetId("")
+	return nil
+}
+
+func deleteMiddleware(resourceData *schema.ResourceData, meta interface{}) error {
+	middlewareId := resourceData.Id()
+	input := &multai.DeleteMiddlewareInput{MiddlewareID: spotinst.String(middlewareId)}
+
+	if json, err := commons.ToJson(input); err != nil {
+		return err
+	} else {
+		log.Printf("===> Middleware delete configuration: %s", json)
+	}
+
+	if _, err := meta.(*Client).multai.DeleteMiddleware(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete middleware: %s", err)
+	}
+	return nil
+}
+
+func awaitMiddlewareDeleted(middlewareId *string, client *Client) error {
+	err := resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		input := &multai.ReadMiddlewareInput{MiddlewareID: spotinst.String(*middlewareId)}
+		resp, err := client.multai.ReadMiddleware(context.Background(), input)
+		if err == nil && resp != nil && resp.Middleware != nil {
+			return resource.RetryableError(fmt.Errorf("===> waiting for middleware to delete <==="))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/multai"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_routing_rule"
 )
 
 func createMultaiRoutingRuleResourceName(name string) string {
@@ -281,3 +282,45 @@ resource "` + string(commons.MultaiRoutingRuleResourceName) + `" "%v" {
    value = "updated"
   }
 }`
+
+func TestMultaiRoutingRule_ValidateRoute(t *testing.T) {
+	valid := []string{
+		"Path(`/bar`)",
+		"Host(`example.com`) && PathPrefix(`/api`)",
+		"Host(`a.example.com`, `b.example.com`) || (Method(\"GET\") && !Headers(`X-Debug`, `1`))",
+	}
+	for _, route := range valid {
+		if warns, errs := multai_routing_rule.ValidateRoute(route, "route"); len(warns) > 0 || len(errs) > 0 {
+			t.Errorf("expected route %q to be valid, got: %v %v", route, warns, errs)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"Path(/bar)",
+		"Path(`bar`)",
+		"Host(`example.com`) &&",
+		"Host(`example.com`",
+		"Headers(`X-Debug`)",
+		"Host(`example.com`) Path(`/bar`)",
+	}
+	for _, route := range invalid {
+		if _, errs := multai_routing_rule.ValidateRoute(route, "route"); len(errs) == 0 {
+			t.Errorf("expected route %q to be invalid", route)
+		}
+	}
+
+	unknown := []string{
+		"Hostname(`example.com`)",
+		"Host(`example.com`) && ClientIP(`10.0.0.0/8`)",
+	}
+	for _, route := range unknown {
+		warns, errs := multai_routing_rule.ValidateRoute(route, "route")
+		if len(errs) > 0 {
+			t.Errorf("expected route %q to only warn, got: %v", route, errs)
+		}
+		if len(warns) == 0 {
+			t.Errorf("expected a warning for route %q", route)
+		}
+	}
+}
@@ -0,0 +1,453 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/multai"
+	oceanAWS "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/multai_target_set_attachment"
+)
+
+func resourceSpotinstMultaiTargetSetAttachment() *schema.Resource {
+	setupMultaiTargetSetAttachmentResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstMultaiTargetSetAttachmentCreate,
+		ReadContext:   resourceSpotinstMultaiTargetSetAttachmentRead,
+		UpdateContext: resourceSpotinstMultaiTargetSetAttachmentUpdate,
+		DeleteContext: resourceSpotinstMultaiTargetSetAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importMultaiTargetSetAttachment,
+		},
+		Schema: commons.MultaiTargetSetAttachmentResource.GetSchemaMap(),
+	}
+}
+
+func setupMultaiTargetSetAttachmentResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	multai_target_set_attachment.Setup(fieldsMap)
+
+	commons.MultaiTargetSetAttachmentResource = commons.NewMultaiTargetSetAttachmentResource(fieldsMap)
+}
+
+func multaiTargetSetAttachmentId(attachment *commons.MultaiTargetSetAttachment) string {
+	source := attachment.ElastigroupID
+	if source == nil {
+		source = attachment.OceanLaunchSpecID
+	}
+	return fmt.Sprintf("%s:%s", spotinst.StringValue(attachment.TargetSetID), spotinst.StringValue(source))
+}
+
+// importMultaiTargetSetAttachment imports an attachment using an ID of the form
+// `<target_set_id>:<elastigroup_id|ocean_launch_spec_id>`.
+func importMultaiTargetSetAttachment(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(resourceData.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <target_set_id>:<elastigroup_id|ocean_launch_spec_id>", resourceData.Id())
+	}
+
+	sourceField := multai_target_set_attachment.OceanLaunchSpecID
+	if strings.HasPrefix(parts[1], "sig-") {
+		sourceField = multai_target_set_attachment.ElastigroupID
+	}
+
+	if err := resourceData.Set(string(multai_target_set_attachment.TargetSetID), parts[0]); err != nil {
+		return nil, err
+	}
+	if err := resourceData.Set(string(sourceField), parts[1]); err != nil {
+		return nil, err
+	}
+
+	input := &multai.ReadTargetSetInput{TargetSetID: spotinst.String(parts[0])}
+	resp, err := meta.(*Client).multai.ReadTargetSet(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read target set: %s", err)
+	}
+	if resp.TargetSet == nil {
+		return nil, fmt.Errorf("target set %s does not exist", parts[0])
+	}
+	if err := resourceData.Set(string(multai_target_set_attachment.BalancerID), spotinst.StringValue(resp.TargetSet.BalancerID)); err != nil {
+		return nil, err
+	}
+	if err := resourceData.Set(string(multai_target_set_attachment.DrainingTimeout), 300); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+func resourceSpotinstMultaiTargetSetAttachmentCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.MultaiTargetSetAttachmentResource.GetName())
+
+	attachment, err := commons.MultaiTargetSetAttachmentResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	balancers, err := readAttachmentBalancers(ctx, attachment, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if findAttachmentBalancer(balancers, attachment) < 0 {
+		balancer := &aws.LoadBalancer{
+			Type: spotinst.String(string(elastigroup_aws.BalancerTypeMultaiTargetSet)),
+		}
+		balancer.SetBalancerId(attachment.BalancerID)
+		balancer.SetTargetSetId(attachment.TargetSetID)
+
+		if err := updateAttachmentBalancers(ctx, attachment, append(balancers, balancer), meta.(*Client)); err != nil {
+			return diag.Errorf("[ERROR] failed to attach target set: %s", err)
+		}
+	}
+
+	resourceData.SetId(multaiTargetSetAttachmentId(attachment))
+	log.Printf("===> Target Set attached successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstMultaiTargetSetAttachmentRead(ctx, resourceData, meta)
+}
+
+// existingMultaiTargetSetAttachment returns the attachment stored in state.
+func existingMultaiTargetSetAttachment(resourceData *schema.ResourceData) *commons.MultaiTargetSetAttachment {
+	return commons.NewMultaiTargetSetAttachment(
+		resourceData.Get(string(multai_target_set_attachment.BalancerID)).(string),
+		resourceData.Get(string(multai_target_set_attachment.TargetSetID)).(string),
+		resourceData.Get(string(multai_target_set_attachment.ElastigroupID)).(string),
+		resourceData.Get(string(multai_target_set_attachment.OceanLaunchSpecID)).(string))
+}
+
+func findAttachmentBalancer(balancers []*aws.LoadBalancer, attachment *commons.MultaiTargetSetAttachment) int {
+	for i, balancer := range balancers {
+		if spotinst.StringValue(balancer.Type) == string(elastigroup_aws.BalancerTypeMultaiTargetSet) &&
+			spotinst.StringValue(balancer.TargetSetID) == spotinst.StringValue(attachment.TargetSetID) {
+			return i
+		}
+	}
+	return -1
+}
+
+// readAttachmentBalancers returns the load balancers configured on the
+// Elastigroup or Ocean launch spec of the attachment.
+func readAttachmentBalancers(ctx context.Context, attachment *commons.MultaiTargetSetAttachment, spotinstClient *Client) ([]*aws.LoadBalancer, error) {
+	if attachment.ElastigroupID != nil {
+		input := &aws.ReadGroupInput{GroupID: attachment.ElastigroupID}
+		resp, err := spotinstClient.elastigroup.CloudProviderAWS().Read(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read group: %s", err)
+		}
+		group := resp.Group
+		if group == nil || group.Compute == nil || group.Compute.LaunchSpecification == nil ||
+			group.Compute.LaunchSpecification.LoadBalancersConfig == nil {
+			return nil, nil
+		}
+		return group.Compute.LaunchSpecification.LoadBalancersConfig.LoadBalancers, nil
+	}
+
+	input := &oceanAWS.ReadLaunchSpecInput{LaunchSpecID: attachment.OceanLaunchSpecID}
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ReadLaunchSpec(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read launch spec: %s", err)
+	}
+	if resp.LaunchSpec == nil {
+		return nil, nil
+	}
+
+	balancers := make([]*aws.LoadBalancer, 0, len(resp.LaunchSpec.LoadBalancers))
+	for _, lb := range resp.LaunchSpec.LoadBalancers {
+		balancers = append(balancers, &aws.LoadBalancer{
+			Type:        lb.Type,
+			Arn:         lb.Arn,
+			Name:        lb.Name,
+			BalancerID:  lb.BalancerID,
+			TargetSetID: lb.TargetSetID,
+		})
+	}
+	return balancers, nil
+}
+
+func updateAttachmentBalancers(ctx context.Context, attachment *commons.MultaiTargetSetAttachment, balancers []*aws.LoadBalancer, spotinstClient *Client) error {
+	if attachment.ElastigroupID != nil {
+		group := &aws.Group{
+			Compute: &aws.Compute{
+				LaunchSpecification: &aws.LaunchSpecification{
+					LoadBalancersConfig: &aws.LoadBalancersConfig{},
+				},
+			},
+		}
+		group.SetId(attachment.ElastigroupID)
+		group.Compute.LaunchSpecification.LoadBalancersConfig.SetLoadBalancers(balancers)
+
+		if json, err := commons.ToJson(group); err != nil {
+			return err
+		} else {
+			log.Printf("===> Group update configuration: %s", json)
+		}
+
+		_, err := spotinstClient.elastigroup.CloudProviderAWS().Update(ctx, &aws.UpdateGroupInput{Group: group})
+		return err
+	}
+
+	lbs := make([]*oceanAWS.LoadBalancer, 0, len(balancers))
+	for _, balancer := range balancers {
+		lbs = append(lbs, &oceanAWS.LoadBalancer{
+			Type:        balancer.Type,
+			Arn:         balancer.Arn,
+			Name:        balancer.Name,
+			BalancerID:  balancer.BalancerID,
+			TargetSetID: balancer.TargetSetID,
+		})
+	}
+
+	launchSpec := &oceanAWS.LaunchSpec{}
+	launchSpec.SetId(attachment.OceanLaunchSpecID)
+	launchSpec.SetLoadBalancers(lbs)
+
+	if json, err := commons.ToJson(launchSpec); err != nil {
+		return err
+	} else {
+		log.Printf("===> LaunchSpec update configuration: %s", json)
+	}
+
+	_, err := spotinstClient.ocean.CloudProviderAWS().UpdateLaunchSpec(ctx, &oceanAWS.UpdateLaunchSpecInput{LaunchSpec: launchSpec})
+	return err
+}
+
+// attachmentInstance is an instance of the Elastigroup or Ocean launch spec of
+// an attachment, along with the addresses a target may use as its host.
+type attachmentInstance struct {
+	id        string
+	addresses []string
+}
+
+// listAttachmentInstances returns the instances that currently run in the
+// Elastigroup or Ocean launch spec of the attachment.
+func listAttachmentInstances(ctx context.Context, attachment *commons.MultaiTargetSetAttachment, spotinstClient *Client) ([]*attachmentInstance, error) {
+	var instances []*attachmentInstance
+
+	if attachment.ElastigroupID != nil {
+		input := &aws.StatusGroupInput{GroupID: attachment.ElastigroupID}
+		resp, err := spotinstClient.elastigroup.CloudProviderAWS().Status(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read group status: %s", err)
+		}
+		for _, instance := range resp.Instances {
+			instances = append(instances, newAttachmentInstance(instance.ID, instance.PrivateIP, instance.PublicIP))
+		}
+		return instances, nil
+	}
+
+	lsInput := &oceanAWS.ReadLaunchSpecInput{LaunchSpecID: attachment.OceanLaunchSpecID}
+	lsResp, err := spotinstClient.ocean.CloudProviderAWS().ReadLaunchSpec(ctx, lsInput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read launch spec: %s", err)
+	}
+	if lsResp.LaunchSpec == nil {
+		return nil, nil
+	}
+
+	input := &oceanAWS.ReadClusterNodeInput{
+		ClusterID:    lsResp.LaunchSpec.OceanID,
+		LaunchSpecId: attachment.OceanLaunchSpecID,
+	}
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ReadClusterNodes(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster nodes: %s", err)
+	}
+
+	// Cluster nodes carry no private IP, so it is resolved from the cluster
+	// instances, which targets are usually registered with.
+	instancesInput := &oceanAWS.ListClusterInstancesInput{ClusterID: lsResp.LaunchSpec.OceanID}
+	instancesResp, err := spotinstClient.ocean.CloudProviderAWS().ListClusterInstances(ctx, instancesInput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster instances: %s", err)
+	}
+	privateIPs := make(map[string]*string)
+	for _, instance := range instancesResp.Instances {
+		privateIPs[spotinst.StringValue(instance.ID)] = instance.PrivateIP
+	}
+
+	for _, node := range resp.ClusterNode {
+		instances = append(instances, newAttachmentInstance(node.InstanceId,
+			privateIPs[spotinst.StringValue(node.InstanceId)], node.PublicIp))
+	}
+	return instances, nil
+}
+
+func newAttachmentInstance(id *string, addresses ...*string) *attachmentInstance {
+	instance := &attachmentInstance{id: spotinst.StringValue(id)}
+	for _, address := range addresses {
+		if v := spotinst.StringValue(address); v != "" {
+			instance.addresses = append(instance.addresses, v)
+		}
+	}
+	return instance
+}
+
+// listAttachmentTargets returns the targets of the target set whose host is
+// one of the addresses of the given instances, and the IDs of the instances
+// that have no such target. Targets whose host matches none of the instances
+// belong to someone else and are left out.
+func listAttachmentTargets(ctx context.Context, attachment *commons.MultaiTargetSetAttachment, instances []*attachmentInstance, spotinstClient *Client) ([]*multai.Target, []string, error) {
+	input := &multai.ListTargetsInput{TargetSetID: attachment.TargetSetID}
+	resp, err := spotinstClient.multai.ListTargets(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list targets: %s", err)
+	}
+
+	owners := make(map[string]string)
+	for _, instance := range instances {
+		for _, address := range instance.addresses {
+			owners[address] = instance.id
+		}
+	}
+
+	members := make([]*multai.Target, 0)
+	registered := make(map[string]bool)
+	for _, target := range resp.Targets {
+		if instanceID, ok := owners[spotinst.StringValue(target.Host)]; ok {
+			members = append(members, target)
+			registered[instanceID] = true
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return spotinst.StringValue(members[i].ID) < spotinst.StringValue(members[j].ID)
+	})
+
+	unregistered := make([]string, 0)
+	for _, instance := range instances {
+		if !registered[instance.id] {
+			unregistered = append(unregistered, instance.id)
+		}
+	}
+	return members, unregistered, nil
+}
+
+func resourceSpotinstMultaiTargetSetAttachmentRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.MultaiTargetSetAttachmentResource.GetName(), id)
+
+	attachment := existingMultaiTargetSetAttachment(resourceData)
+	balancers, err := readAttachmentBalancers(ctx, attachment, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// If the group or launch spec is no longer bound to the target set,
+	// return no state.
+	if findAttachmentBalancer(balancers, attachment) < 0 {
+		resourceData.SetId("")
+		return nil
+	}
+
+	instances, err := listAttachmentInstances(ctx, attachment, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	targets, unregistered, err := listAttachmentTargets(ctx, attachment, instances, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	attachment.Targets = targets
+	attachment.UnregisteredInstanceIDs = unregistered
+
+	if err := commons.MultaiTargetSetAttachmentResource.OnRead(attachment, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Target Set attachment read successfully: %s <===", id)
+	return nil
+}
+
+// resourceSpotinstMultaiTargetSetAttachmentUpdate only handles
+// `draining_timeout`; every other argument creates a new attachment.
+func resourceSpotinstMultaiTargetSetAttachmentUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceSpotinstMultaiTargetSetAttachmentRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstMultaiTargetSetAttachmentDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.MultaiTargetSetAttachmentResource.GetName(), id)
+
+	attachment := existingMultaiTargetSetAttachment(resourceData)
+	balancers, err := readAttachmentBalancers(ctx, attachment, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Stop registering new instances before draining the current ones.
+	if i := findAttachmentBalancer(balancers, attachment); i >= 0 {
+		balancers = append(balancers[:i], balancers[i+1:]...)
+		if len(balancers) == 0 {
+			balancers = nil
+		}
+		if err := updateAttachmentBalancers(ctx, attachment, balancers, meta.(*Client)); err != nil {
+			return diag.Errorf("[ERROR] onDelete() -> Failed to detach target set: %s", err)
+		}
+	}
+
+	instances, err := listAttachmentInstances(ctx, attachment, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	targets, _, err := listAttachmentTargets(ctx, attachment, instances, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	timeout := resourceData.Get(string(multai_target_set_attachment.DrainingTimeout)).(int)
+	if err := drainMultaiTargets(ctx, targets, timeout, meta.(*Client)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Target Set detached successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
+
+// drainMultaiTargets stops sending new requests to the targets, waits for the
+// in-flight requests to complete and then deregisters the targets.
+func drainMultaiTargets(ctx context.Context, targets []*multai.Target, timeout int, spotinstClient *Client) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	for _, target := range targets {
+		target.SetWeight(spotinst.Int(0))
+		if _, err := spotinstClient.multai.UpdateTarget(ctx, &multai.UpdateTargetInput{Target: target}); err != nil {
+			return fmt.Errorf("[ERROR] Failed to drain target [%v]: %v", spotinst.StringValue(target.ID), err)
+		}
+	}
+
+	log.Printf("===> waiting %d seconds for %d targets to drain <===", timeout, len(targets))
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Second * time.Duration(timeout)):
+	}
+
+	for _, target := range targets {
+		input := &multai.DeleteTargetInput{TargetID: target.ID}
+		if _, err := spotinstClient.multai.DeleteTarget(ctx, input); err != nil {
+			return fmt.Errorf("[ERROR] Failed to deregister target [%v]: %v", spotinst.StringValue(target.ID), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package spotinst
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createMultaiTargetSetAttachmentResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.MultaiTargetSetAttachmentResourceName), name)
+}
+
+func testAccCheckSpotinstMultaiTargetSetAttached(group *aws.Group, attached bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		found := false
+		if group.Compute != nil && group.Compute.LaunchSpecification != nil &&
+			group.Compute.LaunchSpecification.LoadBalancersConfig != nil {
+			for _, lb := range group.Compute.LaunchSpecification.LoadBalancersConfig.LoadBalancers {
+				if spotinst.StringValue(lb.Type) == "MULTAI_TARGET_SET" {
+					found = true
+				}
+			}
+		}
+		if found != attached {
+			return fmt.Errorf("expected target set attached to be %v, got %v", attached, found)
+		}
+		return nil
+	}
+}
+
+func createMultaiTargetSetAttachmentTerraform(name, groupName string) string {
+	template := createElastigroupTerraform(&GroupConfigMetadata{
+		groupName:      groupName,
+		fieldsToAppend: testTargetSetAttachmentGroupConfig,
+	})
+
+	template += fmt.Sprintf(testBaselineTargetSetAttachmentConfig,
+		name,
+		groupName,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", name, template)
+	return template
+}
+
+func TestAccSpotinstMultaiTargetSetAttachment_Elastigroup(t *testing.T) {
+	attachmentName := "target-set-attachment-baseline"
+	groupName := "eg-target-set-attachment"
+	resourceName := createMultaiTargetSetAttachmentResourceName(attachmentName)
+	groupResourceName := createElastigroupResourceName(groupName)
+
+	var group aws.Group
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createMultaiTargetSetAttachmentTerraform(attachmentName, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, groupResourceName),
+					testAccCheckSpotinstMultaiTargetSetAttached(&group, true),
+					resource.TestCheckResourceAttrPair(resourceName, "elastigroup_id", groupResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "unregistered_instance_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"draining_timeout"},
+			},
+		},
+	})
+}
+
+const testTargetSetAttachmentGroupConfig = `
+ lifecycle {
+   ignore_changes = [multai_target_sets]
+ }
+`
+
+const testBaselineTargetSetAttachmentConfig = `
+resource "spotinst_multai_balancer" "foo" {
+  provider = "aws"
+  name = "test-acc-foo"
+
+  connection_timeouts {
+    idle     = 10
+    draining = 10
+  }
+}
+
+resource "spotinst_multai_target_set" "foo" {
+  provider = "aws"
+  balancer_id   = "${spotinst_multai_balancer.foo.id}"
+  deployment_id = "dp-12345"
+  name          = "test-acc-bar"
+  protocol      = "http"
+  port          = 1338
+  weight        = 2
+
+  health_check {
+    protocol            = "http"
+    path                = "/"
+    port                = 3001
+    interval            = 20
+    timeout             = 5
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+  }
+}
+
+resource "` + string(commons.MultaiTargetSetAttachmentResourceName) + `" "%v" {
+  provider = "aws"
+  balancer_id      = "${spotinst_multai_balancer.foo.id}"
+  target_set_id    = "${spotinst_multai_target_set.foo.id}"
+  elastigroup_id   = "${` + string(commons.ElastigroupAWSResourceName) + `.%v.id}"
+  draining_timeout = 0
+}`
@@ -0,0 +1,404 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	oceanAWS "github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/notification_policy"
+
+	subscriptionPackage "github.com/spotinst/terraform-provider-spotinst/spotinst/subscription"
+)
+
+// notificationPolicyEventTypesByResourceType maps the policy resource types to
+// the subscription event type catalog.
+var notificationPolicyEventTypesByResourceType = map[string]string{
+	notification_policy.ResourceTypeElastigroupAWS: subscriptionPackage.ResourceTypeElastigroupAWS,
+	notification_policy.ResourceTypeOceanAWS:       subscriptionPackage.ResourceTypeOcean,
+}
+
+func resourceSpotinstNotificationPolicy() *schema.Resource {
+	setupNotificationPolicy()
+	return &schema.Resource{
+		CreateContext: resourceSpotinstNotificationPolicyCreate,
+		UpdateContext: resourceSpotinstNotificationPolicyUpdate,
+		ReadContext:   resourceSpotinstNotificationPolicyRead,
+		DeleteContext: resourceSpotinstNotificationPolicyDelete,
+
+		CustomizeDiff: resourceSpotinstNotificationPolicyCustomizeDiff,
+
+		Schema: commons.NotificationPolicyResource.GetSchemaMap(),
+	}
+}
+
+func setupNotificationPolicy() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	notification_policy.Setup(fieldsMap)
+
+	commons.NotificationPolicyResource = commons.NewNotificationPolicyResource(fieldsMap)
+}
+
+func resourceSpotinstNotificationPolicyCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	resourceType := diff.Get(string(notification_policy.ResourceType)).(string)
+	policy := &commons.NotificationPolicy{
+		ResourceType: spotinst.String(resourceType),
+	}
+
+	// Endpoints that are only known after apply can't be counted, but the
+	// rest of the configuration is still validated.
+	endpointsKnown := true
+	for _, field := range []commons.FieldName{
+		notification_policy.EmailAddresses,
+		notification_policy.SlackWebhookURLs,
+		notification_policy.SNSTopicARNs,
+		notification_policy.WebhookURLs,
+	} {
+		if !diff.NewValueKnown(string(field)) {
+			endpointsKnown = false
+			continue
+		}
+		if v, ok := diff.GetOk(string(field)); ok {
+			for _, endpoint := range v.(*schema.Set).List() {
+				policy.Endpoints = append(policy.Endpoints, &commons.NotificationPolicyEndpoint{
+					Protocol: spotinst.String(notification_policy.EndpointProtocols[field]),
+					Endpoint: spotinst.String(endpoint.(string)),
+				})
+			}
+		}
+	}
+	if endpointsKnown && len(policy.Endpoints) == 0 {
+		return fmt.Errorf("at least one of %q, %q, %q or %q must be configured",
+			notification_policy.EmailAddresses, notification_policy.SlackWebhookURLs,
+			notification_policy.SNSTopicARNs, notification_policy.WebhookURLs)
+	}
+
+	eventTypesKnown := diff.NewValueKnown(string(notification_policy.EventTypes))
+	if eventTypesKnown {
+		supported := subscriptionPackage.EventTypesByResourceType[notificationPolicyEventTypesByResourceType[resourceType]]
+		for _, v := range diff.Get(string(notification_policy.EventTypes)).(*schema.Set).List() {
+			eventType := strings.ToUpper(v.(string))
+			found := false
+			for _, s := range supported {
+				if s == eventType {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("event type %q is not supported for resource type %q, expected one of: %s",
+					eventType, resourceType, strings.Join(supported, ", "))
+			}
+			policy.EventTypes = append(policy.EventTypes, eventType)
+		}
+	}
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// The required subscriptions can't be computed before apply.
+	if !endpointsKnown || !eventTypesKnown || !diff.NewValueKnown(string(notification_policy.TagSelector)) {
+		return diff.SetNewComputed(string(notification_policy.Subscriptions))
+	}
+
+	// Resources matching the selector may have been created or re-tagged
+	// since the last apply; surface that as a diff so the next apply picks
+	// them up.
+	if v, ok := diff.GetOk(string(notification_policy.TagSelector)); ok {
+		policy.TagSelectors = notification_policy.ExpandTagSelectors(v)
+	}
+
+	desired, err := buildNotificationPolicySubscriptions(ctx, policy, meta.(*Client))
+	if err != nil {
+		return err
+	}
+
+	current := notification_policy.ExpandSubscriptions(diff.Get(string(notification_policy.Subscriptions)))
+	if len(current) != len(desired) {
+		return diff.SetNewComputed(string(notification_policy.Subscriptions))
+	}
+	for _, sub := range current {
+		key := notificationPolicySubscriptionKey(spotinst.StringValue(sub.ResourceID), spotinst.StringValue(sub.EventType),
+			spotinst.StringValue(sub.Protocol), spotinst.StringValue(sub.Endpoint))
+		if _, ok := desired[key]; !ok {
+			return diff.SetNewComputed(string(notification_policy.Subscriptions))
+		}
+	}
+
+	return nil
+}
+
+func notificationPolicySubscriptionKey(resourceId, eventType, protocol, endpoint string) string {
+	return strings.Join([]string{resourceId, eventType, protocol, endpoint}, "|")
+}
+
+// resolveNotificationPolicyTargets returns the IDs of all resources of the
+// policy's resource type whose tags match every tag selector.
+func resolveNotificationPolicyTargets(ctx context.Context, policy *commons.NotificationPolicy, spotinstClient *Client) ([]string, error) {
+	var targets []string
+	switch spotinst.StringValue(policy.ResourceType) {
+	case notification_policy.ResourceTypeElastigroupAWS:
+		resp, err := spotinstClient.elastigroup.CloudProviderAWS().List(ctx, &aws.ListGroupsInput{})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] failed to list elastigroups: %s", err)
+		}
+		for _, group := range resp.Groups {
+			tags := make(map[string]string)
+			if group.Compute != nil && group.Compute.LaunchSpecification != nil {
+				for _, tag := range group.Compute.LaunchSpecification.Tags {
+					tags[spotinst.StringValue(tag.Key)] = spotinst.StringValue(tag.Value)
+				}
+			}
+			if matchesNotificationPolicyTagSelectors(tags, policy.TagSelectors) {
+				targets = append(targets, spotinst.StringValue(group.ID))
+			}
+		}
+
+	case notification_policy.ResourceTypeOceanAWS:
+		resp, err := spotinstClient.ocean.CloudProviderAWS().ListClusters(ctx, &oceanAWS.ListClustersInput{})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] failed to list ocean clusters: %s", err)
+		}
+		for _, cluster := range resp.Clusters {
+			tags := make(map[string]string)
+			if cluster.Compute != nil && cluster.Compute.LaunchSpecification != nil {
+				for _, tag := range cluster.Compute.LaunchSpecification.Tags {
+					tags[spotinst.StringValue(tag.Key)] = spotinst.StringValue(tag.Value)
+				}
+			}
+			if matchesNotificationPolicyTagSelectors(tags, policy.TagSelectors) {
+				targets = append(targets, spotinst.StringValue(cluster.ID))
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported resource type %q", spotinst.StringValue(policy.ResourceType))
+	}
+
+	sort.Strings(targets)
+	return targets, nil
+}
+
+func matchesNotificationPolicyTagSelectors(tags map[string]string, selectors []*commons.NotificationPolicyTagSelector) bool {
+	for _, selector := range selectors {
+		value, ok := tags[spotinst.StringValue(selector.Key)]
+		if !ok {
+			return false
+		}
+		if selector.Value != nil && value != spotinst.StringValue(selector.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// buildNotificationPolicySubscriptions returns every subscription the policy
+// requires, keyed by notificationPolicySubscriptionKey.
+func buildNotificationPolicySubscriptions(ctx context.Context, policy *commons.NotificationPolicy, spotinstClient *Client) (map[string]*subscription.Subscription, error) {
+	targets, err := resolveNotificationPolicyTargets(ctx, policy, spotinstClient)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]*subscription.Subscription)
+	for _, resourceId := range targets {
+		for _, eventType := range policy.EventTypes {
+			for _, endpoint := range policy.Endpoints {
+				sub := &subscription.Subscription{}
+				sub.SetResourceId(spotinst.String(resourceId))
+				sub.SetEventType(spotinst.String(eventType))
+				sub.SetProtocol(endpoint.Protocol)
+				sub.SetEndpoint(endpoint.Endpoint)
+				if endpoint.Format != nil {
+					sub.SetFormat(endpoint.Format)
+				} else if policy.Format != nil {
+					sub.SetFormat(policy.Format)
+				}
+				if endpoint.SigningSecret != nil {
+					sub.SetSecret(endpoint.SigningSecret)
+				}
+
+				key := notificationPolicySubscriptionKey(resourceId, eventType,
+					spotinst.StringValue(endpoint.Protocol), spotinst.StringValue(endpoint.Endpoint))
+				out[key] = sub
+			}
+		}
+	}
+	return out, nil
+}
+
+func resourceSpotinstNotificationPolicyCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.NotificationPolicyResource.GetName())
+
+	policy, err := commons.NotificationPolicyResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(resource.PrefixedUniqueId("np-"))
+	if err := reconcileNotificationPolicy(ctx, policy, nil, false, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("===> Notification policy created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstNotificationPolicyRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstNotificationPolicyRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.NotificationPolicyResource.GetName(), id)
+
+	spotinstClient := meta.(*Client)
+	policy := &commons.NotificationPolicy{}
+	for _, sub := range notification_policy.ExpandSubscriptions(resourceData.Get(string(notification_policy.Subscriptions))) {
+		input := &subscription.ReadSubscriptionInput{SubscriptionID: sub.ID}
+		resp, err := spotinstClient.subscription.Read(ctx, input)
+		if err != nil {
+			return diag.Errorf("[ERROR] Failed to read subscription %s: %s", spotinst.StringValue(sub.ID), err)
+		}
+
+		// If the subscription was not found, drop it so it is recreated.
+		if resp.Subscription == nil {
+			continue
+		}
+		policy.Subscriptions = append(policy.Subscriptions, sub)
+	}
+
+	if err := commons.NotificationPolicyResource.OnRead(policy, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Notification policy read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstNotificationPolicyUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.NotificationPolicyResource.GetName(), id)
+
+	_, policy, err := commons.NotificationPolicyResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	payloadChanged := resourceData.HasChanges(
+		string(notification_policy.Format),
+		string(notification_policy.WebhookSigningSecret))
+
+	// CustomizeDiff may have marked `subscriptions` as known after apply, so
+	// the subscriptions that exist are taken from the prior state.
+	old, _ := resourceData.GetChange(string(notification_policy.Subscriptions))
+	current := notification_policy.ExpandSubscriptions(old)
+	if err := reconcileNotificationPolicy(ctx, policy, current, payloadChanged, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Notification policy updated successfully: %s <===", id)
+	return resourceSpotinstNotificationPolicyRead(ctx, resourceData, meta)
+}
+
+// reconcileNotificationPolicy creates the subscriptions the policy requires but
+// are missing, deletes the ones it no longer requires and, when the payload
+// changed, updates the ones that are kept. The resulting set is always written
+// to state, even on failure, so no subscription is leaked.
+func reconcileNotificationPolicy(ctx context.Context, policy *commons.NotificationPolicy, current []*commons.NotificationPolicySubscription,
+	payloadChanged bool, resourceData *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client)
+
+	desired, err := buildNotificationPolicySubscriptions(ctx, policy, client)
+	if err != nil {
+		return err
+	}
+
+	result := make(map[string]*commons.NotificationPolicySubscription)
+	for _, sub := range current {
+		key := notificationPolicySubscriptionKey(spotinst.StringValue(sub.ResourceID), spotinst.StringValue(sub.EventType),
+			spotinst.StringValue(sub.Protocol), spotinst.StringValue(sub.Endpoint))
+		result[key] = sub
+	}
+
+	defer func() {
+		policy.Subscriptions = make([]*commons.NotificationPolicySubscription, 0, len(result))
+		for _, sub := range result {
+			policy.Subscriptions = append(policy.Subscriptions, sub)
+		}
+		sort.Slice(policy.Subscriptions, func(i, j int) bool {
+			return spotinst.StringValue(policy.Subscriptions[i].ID) < spotinst.StringValue(policy.Subscriptions[j].ID)
+		})
+		if err := commons.NotificationPolicyResource.OnRead(policy, resourceData, meta); err != nil {
+			log.Printf("[ERROR] failed to store notification policy subscriptions: %s", err)
+		}
+	}()
+
+	for key, sub := range result {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if err := deleteSubscription(spotinst.StringValue(sub.ID), client); err != nil {
+			return err
+		}
+		delete(result, key)
+	}
+
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		sub := desired[key]
+		if kept, ok := result[key]; ok {
+			if payloadChanged {
+				sub.SetId(kept.ID)
+				if err := updateSubscription(sub, resourceData, meta); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		subscriptionId, err := createSubscription(sub, client)
+		if err != nil {
+			return err
+		}
+		result[key] = &commons.NotificationPolicySubscription{
+			ID:         subscriptionId,
+			ResourceID: sub.ResourceID,
+			EventType:  sub.EventType,
+			Protocol:   sub.Protocol,
+			Endpoint:   sub.Endpoint,
+		}
+	}
+
+	return nil
+}
+
+func resourceSpotinstNotificationPolicyDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.NotificationPolicyResource.GetName(), id)
+
+	for _, sub := range notification_policy.ExpandSubscriptions(resourceData.Get(string(notification_policy.Subscriptions))) {
+		if err := deleteSubscription(spotinst.StringValue(sub.ID), meta.(*Client)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resourceData.SetId("")
+	return nil
+}
@@ -0,0 +1,213 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/subscription"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createNotificationPolicyResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.NotificationPolicyResourceName), name)
+}
+
+func testNotificationPolicyDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.NotificationPolicyResourceName) {
+			continue
+		}
+		count, _ := strconv.Atoi(rs.Primary.Attributes["subscriptions.#"])
+		for i := 0; i < count; i++ {
+			id := rs.Primary.Attributes[fmt.Sprintf("subscriptions.%d.id", i)]
+			input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(id)}
+			resp, err := client.subscription.Read(context.Background(), input)
+			if err == nil && resp != nil && resp.Subscription != nil {
+				return fmt.Errorf("subscription %s still exists", id)
+			}
+		}
+	}
+	return nil
+}
+
+// testCheckNotificationPolicySubscriptionIDs records the subscription IDs on
+// its first run and, on later runs, checks that the same subscriptions are
+// still the ones managed by the policy and that they still exist.
+func testCheckNotificationPolicySubscriptionIDs(resourceName string, ids *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		count, _ := strconv.Atoi(rs.Primary.Attributes["subscriptions.#"])
+		current := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			current = append(current, rs.Primary.Attributes[fmt.Sprintf("subscriptions.%d.id", i)])
+		}
+
+		if *ids == nil {
+			*ids = current
+			return nil
+		}
+		if len(current) != len(*ids) {
+			return fmt.Errorf("expected %d subscriptions, got %d", len(*ids), len(current))
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		for i, id := range *ids {
+			if current[i] != id {
+				return fmt.Errorf("subscription %s was replaced by %s", id, current[i])
+			}
+			input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(id)}
+			if _, err := client.subscription.Read(context.Background(), input); err != nil {
+				return fmt.Errorf("subscription %s no longer exists: %s", id, err)
+			}
+		}
+		return nil
+	}
+}
+
+func createNotificationPolicyTerraform(tfResource string, resourceName string, groupTerraform string) string {
+	template := groupTerraform + "\n" + fmt.Sprintf(tfResource, resourceName)
+
+	log.Printf("Terraform [%v] template:\n%v", resourceName, template)
+	return template
+}
+
+// region Notification Policy: Elastigroup
+func TestAccSpotinstNotificationPolicy_Elastigroup(t *testing.T) {
+	policyName := "notification-policy-eg"
+	policyResourceName := createNotificationPolicyResourceName(policyName)
+
+	groupName := "eg-notification-policy"
+	groupResourceName := createElastigroupResourceName(groupName)
+	groupTerraform := createElastigroupTerraform(&GroupConfigMetadata{
+		groupName:      groupName,
+		fieldsToAppend: testNotificationPolicyGroupTags,
+	})
+
+	var group aws.Group
+	var subscriptionIDs []string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testNotificationPolicyDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createNotificationPolicyTerraform(testNotificationPolicy_Elastigroup_Create, policyName, groupTerraform),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, groupResourceName),
+					resource.TestCheckResourceAttr(policyResourceName, "resource_type", "elastigroup_aws"),
+					resource.TestCheckResourceAttr(policyResourceName, "event_types.#", "2"),
+					resource.TestCheckResourceAttr(policyResourceName, "email_addresses.#", "1"),
+					resource.TestCheckResourceAttr(policyResourceName, "subscriptions.#", "2"),
+					resource.TestCheckResourceAttrPair(policyResourceName, "subscriptions.0.resource_id", groupResourceName, "id"),
+				),
+			},
+			{
+				Config: createNotificationPolicyTerraform(testNotificationPolicy_Elastigroup_Update, policyName, groupTerraform),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, groupResourceName),
+					resource.TestCheckResourceAttr(policyResourceName, "event_types.#", "1"),
+					resource.TestCheckResourceAttr(policyResourceName, "email_addresses.#", "1"),
+					resource.TestCheckResourceAttr(policyResourceName, "webhook_urls.#", "1"),
+					resource.TestCheckResourceAttr(policyResourceName, "subscriptions.#", "2"),
+					testCheckNotificationPolicySubscriptionIDs(policyResourceName, &subscriptionIDs),
+				),
+			},
+			{
+				Config: createNotificationPolicyTerraform(testNotificationPolicy_Elastigroup_UpdateFormat, policyName, groupTerraform),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, groupResourceName),
+					resource.TestCheckResourceAttr(policyResourceName, "format.event", "%event%"),
+					resource.TestCheckResourceAttr(policyResourceName, "subscriptions.#", "2"),
+					testCheckNotificationPolicySubscriptionIDs(policyResourceName, &subscriptionIDs),
+				),
+			},
+		},
+	})
+}
+
+const testNotificationPolicyGroupTags = `
+  tags {
+    key   = "notifications"
+    value = "test-acc-notification-policy"
+  }
+`
+
+const testNotificationPolicy_Elastigroup_Create = `
+resource "` + string(commons.NotificationPolicyResourceName) + `" "%v" {
+  provider = "aws"
+
+  resource_type = "elastigroup_aws"
+  event_types   = ["AWS_EC2_INSTANCE_LAUNCH", "AWS_EC2_INSTANCE_TERMINATE"]
+
+  tag_selector {
+    key   = "notifications"
+    value = "test-acc-notification-policy"
+  }
+
+  email_addresses = ["test@me.com"]
+
+  depends_on = [` + string(commons.ElastigroupAWSResourceName) + `.eg-notification-policy]
+}
+`
+
+const testNotificationPolicy_Elastigroup_Update = `
+resource "` + string(commons.NotificationPolicyResourceName) + `" "%v" {
+  provider = "aws"
+
+  resource_type = "elastigroup_aws"
+  event_types   = ["AWS_EC2_INSTANCE_LAUNCH"]
+
+  tag_selector {
+    key   = "notifications"
+    value = "test-acc-notification-policy"
+  }
+
+  email_addresses        = ["test@me.com"]
+  webhook_urls           = ["https://test.me"]
+  webhook_signing_secret = "test-secret"
+
+  format = {
+    event      = "%%event%%"
+    instanceId = "%%instance-id%%"
+  }
+
+  depends_on = [` + string(commons.ElastigroupAWSResourceName) + `.eg-notification-policy]
+}
+`
+
+const testNotificationPolicy_Elastigroup_UpdateFormat = `
+resource "` + string(commons.NotificationPolicyResourceName) + `" "%v" {
+  provider = "aws"
+
+  resource_type = "elastigroup_aws"
+  event_types   = ["AWS_EC2_INSTANCE_LAUNCH"]
+
+  tag_selector {
+    key   = "notifications"
+    value = "test-acc-notification-policy"
+  }
+
+  email_addresses        = ["test@me.com"]
+  webhook_urls           = ["https://test.me"]
+  webhook_signing_secret = "test-secret"
+
+  format = {
+    event = "%%event%%"
+  }
+
+  depends_on = [` + string(commons.ElastigroupAWSResourceName) + `.eg-notification-policy]
+}
+`
+
+// endregion
@@ -22,6 +22,7 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aks_image"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aks_launch_specification"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aks_load_balancers"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aks_logging"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aks_login"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aks_network"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aks_os_disk"
@@ -61,6 +62,7 @@ func setupClusterAKSResource() {
 	ocean_aks_extensions.Setup(fieldsMap)
 	ocean_aks_load_balancers.Setup(fieldsMap)
 	ocean_aks_network.Setup(fieldsMap)
+	ocean_aks_logging.Setup(fieldsMap)
 
 	commons.OceanAKSResource = commons.NewOceanAKSResource(fieldsMap)
 }
@@ -112,6 +112,7 @@ type OceanAKSMetadata struct {
 	network              string
 	extensions           string
 	login                string
+	logging              string
 	variables            string
 	updateBaselineFields bool
 }
@@ -193,6 +194,7 @@ func createOceanAKSTerraform(clusterMeta *OceanAKSMetadata) string {
 			clusterMeta.health,
 			clusterMeta.loadBalancers,
 			clusterMeta.vmSizes,
+			clusterMeta.logging,
 		)
 	} else {
 		format := testBaselineOceanAKSConfig_Create
@@ -213,6 +215,7 @@ func createOceanAKSTerraform(clusterMeta *OceanAKSMetadata) string {
 			clusterMeta.health,
 			clusterMeta.loadBalancers,
 			clusterMeta.vmSizes,
+			clusterMeta.logging,
 		)
 
 	}
@@ -295,6 +298,7 @@ resource "` + string(commons.OceanAKSResourceName) + `" "%v" {
 %v
 %v
 %v
+%v
 }
 `
 
@@ -321,6 +325,7 @@ resource "` + string(commons.OceanAKSResourceName) + `" "%v" {
 %v
 %v
 %v
+%v
 }
 `
 
@@ -1196,3 +1201,79 @@ const testExtensionsOceanAKSConfig_Update = `
 `
 
 //endregion
+
+// region Ocean AKS : Logging
+func TestAccSpotinstOceanAKS_Logging(t *testing.T) {
+	clusterName := "terraform-tests-do-not-delete"
+	acdIdentifier := "acd-aa5c6795"
+	controllerClusterID := "terraform-Kubernetes-cluster"
+	resourceName := createOceanAKSResourceName(clusterName)
+
+	var cluster azure.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "azure") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAKSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAKSTerraform(&OceanAKSMetadata{
+					clusterName:         clusterName,
+					acdIdentifier:       acdIdentifier,
+					controllerClusterID: controllerClusterID,
+					logging:             testLoggingOceanAKSConfig_Create,
+				}),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAKSExists(&cluster, resourceName),
+					testCheckOceanAKSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.0.s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.0.s3.0.id", "di-5fae075b"),
+				),
+			},
+			{
+				Config: createOceanAKSTerraform(&OceanAKSMetadata{
+					clusterName:          clusterName,
+					acdIdentifier:        acdIdentifier,
+					controllerClusterID:  controllerClusterID,
+					logging:              testLoggingOceanAKSConfig_Update,
+					updateBaselineFields: true,
+				}),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.0.s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.0.s3.0.id", "di-800fb2b3"),
+				),
+			},
+		},
+	})
+}
+
+const testLoggingOceanAKSConfig_Create = `
+  // --- Logging -------------------------------------------------------
+  logging {
+    export {
+      s3 {
+        id = "di-5fae075b"
+      }
+    }
+  }
+  // -------------------------------------------------------------------
+`
+
+const testLoggingOceanAKSConfig_Update = `
+  // --- Logging -------------------------------------------------------
+  logging {
+    export {
+      s3 {
+        id = "di-800fb2b3"
+      }
+    }
+  }
+  // -------------------------------------------------------------------
+`
+
+//endregion
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,6 +33,7 @@ func resourceSpotinstOceanAWS() *schema.Resource {
 		ReadContext:   resourceSpotinstClusterAWSRead,
 		UpdateContext: resourceSpotinstClusterAWSUpdate,
 		DeleteContext: resourceSpotinstClusterAWSDelete,
+		CustomizeDiff: resourceSpotinstClusterAWSCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -58,10 +60,24 @@ func resourceSpotinstClusterAWSCreate(ctx context.Context, resourceData *schema.
 	log.Printf(string(commons.ResourceOnCreate),
 		commons.OceanAWSResource.GetName())
 
+	var importedFields []string
+	if _, ok := resourceData.GetOk(string(ocean_aws.ImportFrom)); ok {
+		imported, err := importOceanAWSCluster(ctx, resourceData, meta.(*Client))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if importedFields, err = commons.FillOceanImportedFields(resourceData, imported); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	cluster, err := commons.OceanAWSResource.OnCreate(resourceData, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := commons.ClearOceanImportedFields(resourceData, importedFields); err != nil {
+		return diag.FromErr(err)
+	}
 
 	clusterID, err := createAWSCluster(resourceData, cluster, meta.(*Client))
 	if err != nil {
@@ -74,6 +90,52 @@ func resourceSpotinstClusterAWSCreate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterAWSRead(ctx, resourceData, meta)
 }
 
+// importOceanAWSCluster returns the launch configuration of the node groups of
+// an EKS cluster, or of an Auto Scaling Group, keyed by the field it fills.
+func importOceanAWSCluster(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (map[string]interface{}, error) {
+	input := &aws.ImportOceanClusterInput{
+		Region: spotinst.String(resourceData.Get(string(ocean_aws.Region)).(string)),
+	}
+
+	importFrom := resourceData.Get(string(ocean_aws.ImportFrom)).([]interface{})
+	if len(importFrom) > 0 && importFrom[0] != nil {
+		m := importFrom[0].(map[string]interface{})
+		if v, ok := m[string(ocean_aws.EKSClusterName)].(string); ok && v != "" {
+			input.EKSClusterName = spotinst.String(v)
+		}
+		if v, ok := m[string(ocean_aws.AutoScalingGroupName)].(string); ok && v != "" {
+			input.AutoScalingGroupName = spotinst.String(v)
+		}
+	}
+
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ImportOceanCluster(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("ocean AWS: import failed to read cluster configuration: %s", err)
+	}
+	if resp.Cluster == nil || resp.Cluster.Compute == nil {
+		return nil, nil
+	}
+
+	compute := resp.Cluster.Compute
+	imported := map[string]interface{}{
+		string(ocean_aws.SubnetIDs): compute.SubnetIDs,
+	}
+	if lc := compute.LaunchSpecification; lc != nil {
+		imported[string(ocean_aws_launch_configuration.SecurityGroups)] = lc.SecurityGroupIDs
+		imported[string(ocean_aws_launch_configuration.ImageID)] = spotinst.StringValue(lc.ImageID)
+		imported[string(ocean_aws_launch_configuration.UserData)] = spotinst.StringValue(lc.UserData)
+		imported[string(ocean_aws_launch_configuration.KeyName)] = spotinst.StringValue(lc.KeyPair)
+		if lc.IAMInstanceProfile != nil {
+			if lc.IAMInstanceProfile.ARN != nil {
+				imported[string(ocean_aws_launch_configuration.IAMInstanceProfile)] = spotinst.StringValue(lc.IAMInstanceProfile.ARN)
+			} else {
+				imported[string(ocean_aws_launch_configuration.IAMInstanceProfile)] = spotinst.StringValue(lc.IAMInstanceProfile.Name)
+			}
+		}
+	}
+	return imported, nil
+}
+
 func createAWSCluster(resourceData *schema.ResourceData, cluster *aws.Cluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
@@ -149,10 +211,99 @@ func resourceSpotinstClusterAWSRead(ctx context.Context, resourceData *schema.Re
 	if err := commons.OceanAWSResource.OnRead(clusterResponse, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	var filteredInstanceTypes []string
+	if v, ok := resourceData.GetOk(string(ocean_aws_instance_types.Filters)); ok {
+		filteredInstanceTypes, err = resolveInstanceTypesFilters(ctx, meta.(*Client), spotinst.StringValue(clusterResponse.Region), v)
+		if err != nil {
+			log.Printf("[WARN] Unable to resolve %s, keeping the previous %s: %s",
+				string(ocean_aws_instance_types.Filters), string(ocean_aws_instance_types.FilteredInstanceTypes), err)
+			filteredInstanceTypes = expandFilteredInstanceTypes(resourceData.Get(string(ocean_aws_instance_types.FilteredInstanceTypes)))
+		}
+	}
+	if err := resourceData.Set(string(ocean_aws_instance_types.FilteredInstanceTypes), filteredInstanceTypes); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(ocean_aws_instance_types.FilteredInstanceTypes), err)
+	}
+
 	log.Printf("===> Cluster read successfully: %s <===", id)
 	return nil
 }
 
+// resourceSpotinstClusterAWSCustomizeDiff requires the subnets and security
+// groups unless they are imported, and resolves the instance types filters so
+// that the matching instance types are shown in the plan.
+func resourceSpotinstClusterAWSCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := commons.RequireOceanFieldsUnlessImported(diff, string(ocean_aws.ImportFrom),
+		string(ocean_aws.SubnetIDs), string(ocean_aws_launch_configuration.SecurityGroups)); err != nil {
+		return err
+	}
+
+	filtersKey := string(ocean_aws_instance_types.Filters)
+	regionKey := string(ocean_aws.Region)
+	if diff.Id() != "" && !diff.HasChange(filtersKey) && !diff.HasChange(regionKey) {
+		return nil
+	}
+
+	if !diff.NewValueKnown(filtersKey) || !diff.NewValueKnown(regionKey) {
+		return diff.SetNewComputed(string(ocean_aws_instance_types.FilteredInstanceTypes))
+	}
+
+	return setFilteredInstanceTypes(ctx, diff, meta.(*Client), diff.Get(regionKey).(string),
+		filtersKey, string(ocean_aws_instance_types.FilteredInstanceTypes))
+}
+
+// setFilteredInstanceTypes sets the planned value of resultKey to the instance
+// types that match the filters in filtersKey. If the filters cannot be
+// resolved, the value is left to be known after apply.
+func setFilteredInstanceTypes(ctx context.Context, diff *schema.ResourceDiff, spotinstClient *Client, region, filtersKey, resultKey string) error {
+	v, ok := diff.GetOk(filtersKey)
+	if !ok {
+		return diff.SetNew(resultKey, []string{})
+	}
+
+	instanceTypes, err := resolveInstanceTypesFilters(ctx, spotinstClient, region, v)
+	if err != nil {
+		log.Printf("[WARN] Unable to resolve %s, %s will be known after apply: %s", filtersKey, resultKey, err)
+		return diff.SetNewComputed(resultKey)
+	}
+	return diff.SetNew(resultKey, instanceTypes)
+}
+
+// expandFilteredInstanceTypes returns the instance types of a filtered
+// instance types attribute.
+func expandFilteredInstanceTypes(data interface{}) []string {
+	list, _ := data.([]interface{})
+	instanceTypes := make([]string, 0, len(list))
+	for _, v := range list {
+		if instanceType, ok := v.(string); ok {
+			instanceTypes = append(instanceTypes, instanceType)
+		}
+	}
+	return instanceTypes
+}
+
+// resolveInstanceTypesFilters returns the instance types of the region that
+// match the instance types filters, sorted by name.
+func resolveInstanceTypesFilters(ctx context.Context, spotinstClient *Client, region string, data interface{}) ([]string, error) {
+	filters, err := ocean_aws_instance_types.ExpandFilters(data, false)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &aws.ListFilteredInstanceTypesInput{
+		Region:  spotinst.String(region),
+		Filters: filters,
+	}
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ListFilteredInstanceTypes(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve instance types filters: %s", err)
+	}
+
+	instanceTypes := resp.InstanceTypes
+	sort.Strings(instanceTypes)
+	return instanceTypes, nil
+}
+
 func resourceSpotinstClusterAWSUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate),
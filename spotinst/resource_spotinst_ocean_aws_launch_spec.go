@@ -26,6 +26,7 @@ func resourceSpotinstOceanAWSLaunchSpec() *schema.Resource {
 		ReadContext:   resourceSpotinstOceanAWSLaunchSpecRead,
 		UpdateContext: resourceSpotinstOceanAWSLaunchSpecUpdate,
 		DeleteContext: resourceSpotinstOceanAWSLaunchSpecDelete,
+		CustomizeDiff: resourceSpotinstOceanAWSLaunchSpecCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -137,10 +138,65 @@ func resourceSpotinstOceanAWSLaunchSpecRead(ctx context.Context, resourceData *s
 	if err := commons.OceanAWSLaunchSpecResource.OnRead(launchSpecResponse, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	var filteredInstanceTypes []string
+	if v, ok := resourceData.GetOk(string(ocean_aws_launch_spec.InstanceTypesFilters)); ok {
+		region, err := readOceanAWSClusterRegion(ctx, meta.(*Client), spotinst.StringValue(launchSpecResponse.OceanID))
+		if err == nil {
+			filteredInstanceTypes, err = resolveInstanceTypesFilters(ctx, meta.(*Client), region, v)
+		}
+		if err != nil {
+			log.Printf("[WARN] Unable to resolve %s, keeping the previous %s: %s",
+				string(ocean_aws_launch_spec.InstanceTypesFilters), string(ocean_aws_launch_spec.FilteredInstanceTypes), err)
+			filteredInstanceTypes = expandFilteredInstanceTypes(resourceData.Get(string(ocean_aws_launch_spec.FilteredInstanceTypes)))
+		}
+	}
+	if err := resourceData.Set(string(ocean_aws_launch_spec.FilteredInstanceTypes), filteredInstanceTypes); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(ocean_aws_launch_spec.FilteredInstanceTypes), err)
+	}
+
 	log.Printf("===> launchSpec read successfully: %s <===", id)
 	return nil
 }
 
+// resourceSpotinstOceanAWSLaunchSpecCustomizeDiff resolves the instance types
+// filters in the region of the Ocean cluster so that the matching instance
+// types are shown in the plan.
+func resourceSpotinstOceanAWSLaunchSpecCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	filtersKey := string(ocean_aws_launch_spec.InstanceTypesFilters)
+	oceanIDKey := string(ocean_aws_launch_spec.OceanID)
+	if diff.Id() != "" && !diff.HasChange(filtersKey) && !diff.HasChange(oceanIDKey) {
+		return nil
+	}
+
+	resultKey := string(ocean_aws_launch_spec.FilteredInstanceTypes)
+	if !diff.NewValueKnown(filtersKey) || !diff.NewValueKnown(oceanIDKey) {
+		return diff.SetNewComputed(resultKey)
+	}
+	if _, ok := diff.GetOk(filtersKey); !ok {
+		return diff.SetNew(resultKey, []string{})
+	}
+
+	region, err := readOceanAWSClusterRegion(ctx, meta.(*Client), diff.Get(oceanIDKey).(string))
+	if err != nil {
+		log.Printf("[WARN] Unable to read the Ocean cluster, %s will be known after apply: %s", resultKey, err)
+		return diff.SetNewComputed(resultKey)
+	}
+	return setFilteredInstanceTypes(ctx, diff, meta.(*Client), region, filtersKey, resultKey)
+}
+
+func readOceanAWSClusterRegion(ctx context.Context, spotinstClient *Client, oceanID string) (string, error) {
+	input := &aws.ReadClusterInput{ClusterID: spotinst.String(oceanID)}
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ReadCluster(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to read cluster: %s", err)
+	}
+	if resp.Cluster == nil {
+		return "", fmt.Errorf("cluster %s not found", oceanID)
+	}
+	return spotinst.StringValue(resp.Cluster.Region), nil
+}
+
 func resourceSpotinstOceanAWSLaunchSpecUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.OceanAWSLaunchSpecResource.GetName(), id)
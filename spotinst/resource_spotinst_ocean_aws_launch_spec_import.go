@@ -0,0 +1,138 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_launch_spec_import"
+)
+
+func resourceSpotinstOceanAWSLaunchSpecImport() *schema.Resource {
+	setupOceanAWSLaunchSpecImportResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstOceanAWSLaunchSpecImportCreate,
+		ReadContext:   resourceSpotinstOceanAWSLaunchSpecImportRead,
+		DeleteContext: resourceSpotinstOceanAWSLaunchSpecImportDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: commons.OceanAWSLaunchSpecImportResource.GetSchemaMap(),
+	}
+}
+
+func setupOceanAWSLaunchSpecImportResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	ocean_aws_launch_spec_import.Setup(fieldsMap)
+
+	commons.OceanAWSLaunchSpecImportResource = commons.NewOceanAWSLaunchSpecImportResource(fieldsMap)
+}
+
+func resourceSpotinstOceanAWSLaunchSpecImportCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate), commons.OceanAWSLaunchSpecImportResource.GetName())
+
+	importedLaunchSpec, err := importOceanAWSLaunchSpec(ctx, resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	launchSpec, err := commons.OceanAWSLaunchSpecImportResource.OnCreate(importedLaunchSpec, resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if json, err := commons.ToJson(launchSpec); err != nil {
+		return diag.FromErr(err)
+	} else {
+		log.Printf("===> LaunchSpec create configuration: %s", json)
+	}
+
+	input := &aws.CreateLaunchSpecInput{LaunchSpec: launchSpec}
+	out, err := meta.(*Client).ocean.CloudProviderAWS().CreateLaunchSpec(ctx, input)
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to create launchSpec: %s", err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(out.LaunchSpec.ID))
+
+	log.Printf("===> launchSpec imported successfully: %s <===", resourceData.Id())
+	return resourceSpotinstOceanAWSLaunchSpecImportRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstOceanAWSLaunchSpecImportRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead), commons.OceanAWSLaunchSpecImportResource.GetName(), id)
+
+	input := &aws.ReadLaunchSpecInput{LaunchSpecID: spotinst.String(id)}
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadLaunchSpec(ctx, input)
+	if err != nil {
+		// If the launchSpec was not found, return nil so that we can show
+		// that it does not exist
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeLaunchSpecNotFound {
+					resourceData.SetId("")
+					return nil
+				}
+			}
+		}
+
+		// Some other error, report it.
+		return diag.Errorf("failed to read launchSpec: %s", err)
+	}
+
+	// if nothing was found, return no state
+	if resp.LaunchSpec == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.OceanAWSLaunchSpecImportResource.OnRead(resp.LaunchSpec, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("===> launchSpec read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstOceanAWSLaunchSpecImportDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.OceanAWSLaunchSpecImportResource.GetName(), id)
+
+	input := &aws.DeleteLaunchSpecInput{LaunchSpecID: spotinst.String(id)}
+	if _, err := meta.(*Client).ocean.CloudProviderAWS().DeleteLaunchSpec(ctx, input); err != nil {
+		return diag.Errorf("[ERROR] onDelete() -> Failed to delete launchSpec: %s", err)
+	}
+
+	log.Printf("===> launchSpec deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
+
+// importOceanAWSLaunchSpec returns the launch spec Spotinst builds from an
+// EKS managed node group, including its labels and taints. The launch spec
+// is not created.
+func importOceanAWSLaunchSpec(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) (*aws.LaunchSpec, error) {
+	input := &aws.ImportOceanEKSLaunchSpecInput{
+		OceanID:        spotinst.String(resourceData.Get(string(ocean_aws_launch_spec_import.OceanID)).(string)),
+		EKSClusterName: spotinst.String(resourceData.Get(string(ocean_aws_launch_spec_import.EKSClusterName)).(string)),
+		NodeGroupName:  spotinst.String(resourceData.Get(string(ocean_aws_launch_spec_import.NodeGroupName)).(string)),
+	}
+
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ImportOceanEKSLaunchSpec(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("ocean AWS: import failed to read node group: %s", err)
+	}
+
+	return resp.LaunchSpec, nil
+}
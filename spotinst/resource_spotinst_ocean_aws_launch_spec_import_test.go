@@ -0,0 +1,108 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAWSLaunchSpecImportResource(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OceanAWSLaunchSpecImportResourceName), name)
+}
+
+func testOceanAWSLaunchSpecImportDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OceanAWSLaunchSpecImportResourceName) {
+			continue
+		}
+		input := &aws.ReadLaunchSpecInput{LaunchSpecID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderAWS().ReadLaunchSpec(context.Background(), input)
+		if err == nil && resp != nil && resp.LaunchSpec != nil {
+			return fmt.Errorf("launch spec still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckOceanAWSLaunchSpecImportExists(launchSpec *aws.LaunchSpec, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		input := &aws.ReadLaunchSpecInput{LaunchSpecID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderAWS().ReadLaunchSpec(context.Background(), input)
+		if err != nil {
+			return err
+		}
+		if spotinst.StringValue(resp.LaunchSpec.ID) != rs.Primary.Attributes["id"] {
+			return fmt.Errorf("LaunchSpec not found: %+v,\n %+v\n", resp.LaunchSpec, rs.Primary.Attributes)
+		}
+		*launchSpec = *resp.LaunchSpec
+		return nil
+	}
+}
+
+func createOceanAWSLaunchSpecImportTerraform(oceanID string, format string) string {
+	template :=
+		`provider "aws" {
+	token   = "fake"
+	account = "fake"
+	}
+	`
+	template += fmt.Sprintf(format, oceanID, oceanID)
+
+	log.Printf("Terraform LaunchSpec template:\n%v", template)
+	return template
+}
+
+// region Ocean AWS LaunchSpec Import: Baseline
+func TestAccSpotinstOceanAWSLaunchSpecImport_Baseline(t *testing.T) {
+	oceanID := "o-323b5842"
+	resourceName := createOceanAWSLaunchSpecImportResource(oceanID)
+
+	var launchSpec aws.LaunchSpec
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSLaunchSpecImportDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAWSLaunchSpecImportTerraform(oceanID, testBaselineOceanAWSLaunchSpecImportConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSLaunchSpecImportExists(&launchSpec, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ocean_id", oceanID),
+					resource.TestCheckResourceAttr(resourceName, "eks_cluster_name", "terraform-eks-cluster"),
+					resource.TestCheckResourceAttr(resourceName, "node_group_name", "ng-1"),
+					resource.TestCheckResourceAttr(resourceName, "name", "terraform-tests-ng-1"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOceanAWSLaunchSpecImportConfig_Create = `
+resource "` + string(commons.OceanAWSLaunchSpecImportResourceName) + `" "%v" {
+ provider = "aws"
+ ocean_id = "%v"
+ eks_cluster_name = "terraform-eks-cluster"
+ node_group_name = "ng-1"
+ name = "terraform-tests-ng-1"
+}
+
+`
+
+// endregion
@@ -1010,3 +1010,119 @@ resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
 `
 
 //endregion
+
+// region OceanAWSLaunchSpec: InstanceTypesFilters
+func TestAccSpotinstOceanAWSLaunchSpec_InstanceTypesFilters(t *testing.T) {
+	oceanID := "o-323b5842"
+	resourceName := createOceanAWSLaunchSpecResourceOceanID(oceanID)
+
+	var launchSpec aws.LaunchSpec
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSLaunchSpecDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAWSLaunchSpecTerraform(&LaunchSpecConfigMetadata{
+					oceanID: oceanID,
+				}, testLaunchSpecOceanAWSInstanceTypesFilters_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSLaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanAWSLaunchSpecAttributes(&launchSpec, oceanID),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.0.min_vcpu", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.0.max_vcpu", "16"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.0.min_memory_gib", "4"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.0.max_memory_gib", "64"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.0.exclude_metal", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "filtered_instance_types.0"),
+				),
+			},
+			{
+				Config: createOceanAWSLaunchSpecTerraform(&LaunchSpecConfigMetadata{
+					oceanID:              oceanID,
+					updateBaselineFields: true}, testLaunchSpecOceanAWSInstanceTypesFilters_Update),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSLaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanAWSLaunchSpecAttributes(&launchSpec, oceanID),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.0.include_families.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.0.max_vcpu", "8"),
+					resource.TestCheckResourceAttrSet(resourceName, "filtered_instance_types.0"),
+				),
+			},
+			{
+				Config: createOceanAWSLaunchSpecTerraform(&LaunchSpecConfigMetadata{
+					oceanID:              oceanID,
+					updateBaselineFields: true}, testLaunchSpecOceanAWSInstanceTypesFilters_Delete),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSLaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanAWSLaunchSpecAttributes(&launchSpec, oceanID),
+					resource.TestCheckResourceAttr(resourceName, "instance_types_filters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filtered_instance_types.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testLaunchSpecOceanAWSInstanceTypesFilters_Create = `
+resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+ ocean_id = "%v"
+
+ image_id = "ami-05f840082fe2dcac2"
+ security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
+ name = "launch spec name test"
+
+  instance_types_filters {
+    categories     = ["General_purpose", "Compute_optimized"]
+    min_vcpu       = 2
+    max_vcpu       = 16
+    min_memory_gib = 4
+    max_memory_gib = 64
+    exclude_metal  = true
+  }
+
+%v
+}
+
+`
+
+const testLaunchSpecOceanAWSInstanceTypesFilters_Update = `
+resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+ ocean_id = "%v"
+
+ image_id = "ami-05f840082fe2dcac2"
+ security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
+ name = "launch spec name test"
+
+  instance_types_filters {
+    include_families = ["m5*", "c5*"]
+    min_vcpu         = 2
+    max_vcpu         = 8
+    architectures    = ["x86_64"]
+  }
+
+%v
+}
+
+`
+
+const testLaunchSpecOceanAWSInstanceTypesFilters_Delete = `
+resource "` + string(commons.OceanAWSLaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+ ocean_id = "%v"
+
+ image_id = "ami-05f840082fe2dcac2"
+ security_groups = ["sg-0041bd3fd6aa2ee3c", "sg-0195f2ac3a6014a15"]
+ name = "launch spec name test"
+
+%v
+}
+
+`
+
+// endregion
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -1112,3 +1113,153 @@ const testLoggingAWSConfig_EmptyFields = `
 `
 
 // endregion
+
+// region OceanAWS: Import From
+func TestAccSpotinstOceanAWS_ImportFrom(t *testing.T) {
+	clusterName := "test-acc-cluster-import-from"
+	controllerClusterID := "import-from-controller-id"
+	resourceName := createOceanAWSResourceName(clusterName)
+
+	var cluster aws.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	` + fmt.Sprintf(testImportFromAWSConfig_Create, clusterName, clusterName, controllerClusterID),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					testCheckOceanAWSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "import_from.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_from.0.eks_cluster_name", "terraform-eks-cluster"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "security_groups.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "image_id", ""),
+				),
+			},
+			{
+				Config: `provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	` + fmt.Sprintf(testImportFromAWSConfig_Create, clusterName, clusterName, controllerClusterID),
+				PlanOnly: true,
+			},
+			{
+				Config: `provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	` + fmt.Sprintf(testImportFromAWSConfig_Missing, clusterName, clusterName, controllerClusterID),
+				ExpectError: regexp.MustCompile("subnet_ids is required unless import_from is set"),
+			},
+		},
+	})
+}
+
+const testImportFromAWSConfig_Create = `
+resource "` + string(commons.OceanAWSResourceName) + `" "%v" {
+  provider = "aws"
+
+  name          = "%v"
+  controller_id = "%v"
+  region        = "us-west-2"
+
+  max_size         = 1000
+  min_size         = 0
+  desired_capacity = 1
+
+ // --- IMPORT FROM -----------------------
+  import_from {
+    eks_cluster_name = "terraform-eks-cluster"
+  }
+ // ---------------------------------------
+}
+`
+
+const testImportFromAWSConfig_Missing = `
+resource "` + string(commons.OceanAWSResourceName) + `" "%v" {
+  provider = "aws"
+
+  name          = "%v"
+  controller_id = "%v"
+  region        = "us-west-2"
+
+  max_size         = 1000
+  min_size         = 0
+  desired_capacity = 1
+}
+`
+
+// endregion
+
+// region OceanAWS: Instance Types Filters
+func TestAccSpotinstOceanAWS_InstanceTypesFilters(t *testing.T) {
+	clusterName := "test-acc-cluster-instance-types-filters"
+	controllerClusterID := "instance-types-filters-controller-id"
+	resourceName := createOceanAWSResourceName(clusterName)
+
+	var cluster aws.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanAWSTerraform(&ClusterConfigMetadata{
+					clusterName:         clusterName,
+					controllerClusterID: controllerClusterID,
+					instanceWhitelist:   testInstanceTypesFiltersAWSConfig_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					testCheckOceanAWSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.min_vcpu", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filters.0.max_vcpu", "16"),
+					resource.TestCheckResourceAttrSet(resourceName, "filtered_instance_types.0"),
+				),
+			},
+			{
+				Config: createOceanAWSTerraform(&ClusterConfigMetadata{
+					clusterName:         clusterName,
+					controllerClusterID: controllerClusterID,
+					instanceWhitelist:   testInstanceTypesFiltersAWSConfig_EmptyFields,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanAWSExists(&cluster, resourceName),
+					testCheckOceanAWSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "filters.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "filtered_instance_types.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testInstanceTypesFiltersAWSConfig_Create = `
+ // --- INSTANCE TYPES FILTERS ------------
+  filters {
+    categories     = ["General_purpose"]
+    min_vcpu       = 2
+    max_vcpu       = 16
+    min_memory_gib = 4
+    max_memory_gib = 64
+    exclude_metal  = true
+  }
+ // ---------------------------------------
+`
+
+const testInstanceTypesFiltersAWSConfig_EmptyFields = `
+ // --- INSTANCE TYPES FILTERS ------------
+ // ---------------------------------------
+`
+
+// endregion
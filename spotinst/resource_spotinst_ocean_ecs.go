@@ -36,6 +36,8 @@ func resourceSpotinstOceanECS() *schema.Resource {
 		ReadContext:   resourceSpotinstClusterECSRead,
 		UpdateContext: resourceSpotinstClusterECSUpdate,
 		DeleteContext: resourceSpotinstClusterECSDelete,
+		CustomizeDiff: resourceSpotinstClusterECSCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -61,11 +63,26 @@ func resourceSpotinstClusterECSCreate(ctx context.Context, resourceData *schema.
 	log.Printf(string(commons.ResourceOnCreate),
 		commons.OceanECSResource.GetName())
 
+	var importedFields []string
+	if resourceData.Get(string(ocean_ecs.ImportFromCluster)).(bool) {
+		imported, err := importOceanECSCluster(ctx, resourceData, meta.(*Client))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if importedFields, err = commons.FillOceanImportedFields(resourceData, imported); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	cluster, err := commons.OceanECSResource.OnCreate(resourceData, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	if err := commons.ClearOceanImportedFields(resourceData, importedFields); err != nil {
+		return diag.FromErr(err)
+	}
+
 	clusterID, err := createECSCluster(resourceData, cluster, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
@@ -77,6 +94,51 @@ func resourceSpotinstClusterECSCreate(ctx context.Context, resourceData *schema.
 	return resourceSpotinstClusterECSRead(ctx, resourceData, meta)
 }
 
+// importOceanECSCluster returns the launch specification of the container
+// instances of the ECS cluster and their Auto Scaling Group, keyed by the field
+// it fills.
+func importOceanECSCluster(ctx context.Context, resourceData *schema.ResourceData, spotinstClient *Client) (map[string]interface{}, error) {
+	input := &aws.ImportECSClusterInput{
+		ClusterName: spotinst.String(resourceData.Get(string(ocean_ecs.ClusterName)).(string)),
+		Region:      spotinst.String(resourceData.Get(string(ocean_ecs.Region)).(string)),
+	}
+
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ImportECSCluster(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("ocean ECS: import failed to read cluster: %s", err)
+	}
+	if resp.Cluster == nil || resp.Cluster.Compute == nil {
+		return nil, nil
+	}
+
+	compute := resp.Cluster.Compute
+	imported := map[string]interface{}{
+		string(ocean_ecs.SubnetIDs): compute.SubnetIDs,
+	}
+	if lc := compute.LaunchSpecification; lc != nil {
+		imported[string(ocean_ecs_launch_specification.SecurityGroupIds)] = lc.SecurityGroupIDs
+		imported[string(ocean_ecs_launch_specification.ImageID)] = spotinst.StringValue(lc.ImageID)
+		imported[string(ocean_ecs_launch_specification.UserData)] = spotinst.StringValue(lc.UserData)
+		imported[string(ocean_ecs_launch_specification.KeyPair)] = spotinst.StringValue(lc.KeyPair)
+		if lc.IAMInstanceProfile != nil {
+			if lc.IAMInstanceProfile.ARN != nil {
+				imported[string(ocean_ecs_launch_specification.IamInstanceProfile)] = spotinst.StringValue(lc.IAMInstanceProfile.ARN)
+			} else {
+				imported[string(ocean_ecs_launch_specification.IamInstanceProfile)] = spotinst.StringValue(lc.IAMInstanceProfile.Name)
+			}
+		}
+	}
+
+	return imported, nil
+}
+
+// resourceSpotinstClusterECSCustomizeDiff requires the subnets and security
+// groups unless they are imported.
+func resourceSpotinstClusterECSCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return commons.RequireOceanFieldsUnlessImported(diff, string(ocean_ecs.ImportFromCluster),
+		string(ocean_ecs.SubnetIDs), string(ocean_ecs_launch_specification.SecurityGroupIds))
+}
+
 func createECSCluster(resourceData *schema.ResourceData, cluster *aws.ECSCluster, spotinstClient *Client) (*string, error) {
 	if json, err := commons.ToJson(cluster); err != nil {
 		return nil, err
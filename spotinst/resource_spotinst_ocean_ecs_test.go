@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -1003,3 +1004,77 @@ const testLogging_Create = `
 const testLogging_EmptyFields = ``
 
 // endregion
+
+// region OceanECS: Import From Cluster
+func TestAccSpotinstOceanECS_ImportFromCluster(t *testing.T) {
+	clusterName := "test-acc-cluster-ecs-import"
+	resourceName := createOceanECSResourceName(clusterName)
+
+	var cluster aws.ECSCluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanECSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	` + fmt.Sprintf(testImportFromClusterECSConfig_Create, clusterName, clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanECSExists(&cluster, resourceName),
+					testCheckOceanECSAttributes(&cluster, clusterName),
+					resource.TestCheckResourceAttr(resourceName, "import_from_cluster", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", "terraform-ecs-cluster"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "security_group_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "image_id", ""),
+				),
+			},
+			{
+				Config: `provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	` + fmt.Sprintf(testImportFromClusterECSConfig_Create, clusterName, clusterName),
+				PlanOnly: true,
+			},
+			{
+				Config: `provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	` + fmt.Sprintf(testImportFromClusterECSConfig_Missing, clusterName, clusterName),
+				ExpectError: regexp.MustCompile("subnet_ids is required unless import_from_cluster is set"),
+			},
+		},
+	})
+}
+
+const testImportFromClusterECSConfig_Create = `
+resource "` + string(commons.OceanECSResourceName) + `" "%v" {
+  provider = "aws"
+
+  name         = "%v"
+  cluster_name = "terraform-ecs-cluster"
+  region       = "us-west-2"
+
+ // --- IMPORT FROM CLUSTER ---------------
+  import_from_cluster = true
+ // ---------------------------------------
+}
+`
+
+const testImportFromClusterECSConfig_Missing = `
+resource "` + string(commons.OceanECSResourceName) + `" "%v" {
+  provider = "aws"
+
+  name         = "%v"
+  cluster_name = "terraform-ecs-cluster"
+  region       = "us-west-2"
+}
+`
+
+// endregion
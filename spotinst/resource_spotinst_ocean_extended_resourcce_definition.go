@@ -44,8 +44,12 @@ func setupOceanAWSExtendedResourceDefinitionResource() {
 const ErrCodeExtendedResourceDefinitionNotFound = "EXTENDED_RESOURCE_DEFINITION_DOESNT_EXIST"
 
 func resourceSpotinstOceanAWSExtendedResourceDefinitionRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readOceanExtendedResourceDefinition(commons.OceanAWSExtendedResourceDefinitionResource, resourceData, meta)
+}
+
+func readOceanExtendedResourceDefinition(res *commons.OceanAWSExtendedResourceDefinitionTerraformResource, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	resourceId := resourceData.Id()
-	log.Printf(string(commons.ResourceOnRead), commons.OceanAWSExtendedResourceDefinitionResource.GetName(), resourceId)
+	log.Printf(string(commons.ResourceOnRead), res.GetName(), resourceId)
 
 	input := &aws.ReadExtendedResourceDefinitionInput{ExtendedResourceDefinitionID: spotinst.String(resourceId)}
 	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadExtendedResourceDefinition(context.Background(), input)
@@ -70,7 +74,7 @@ func resourceSpotinstOceanAWSExtendedResourceDefinitionRead(ctx context.Context,
 		return nil
 	}
 
-	if err := commons.OceanAWSExtendedResourceDefinitionResource.OnRead(ExtendedResourceDefinitionResponse, resourceData, meta); err != nil {
+	if err := res.OnRead(ExtendedResourceDefinitionResponse, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
 	log.Printf("===> ExtendedResourceDefinition read successfully: %s <===", resourceId)
@@ -0,0 +1,93 @@
+package spotinst
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_extended_resource_definition"
+)
+
+// resourceSpotinstOceanGKEExtendedResourceDefinition manages an extended
+// resource definition whose resource_mapping is keyed by GCP machine type. It
+// is backed by the same Ocean API as the AWS resource.
+func resourceSpotinstOceanGKEExtendedResourceDefinition() *schema.Resource {
+	setupOceanGKEExtendedResourceDefinitionResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstOceanGKEExtendedResourceDefinitionCreate,
+		UpdateContext: resourceSpotinstOceanGKEExtendedResourceDefinitionUpdate,
+		ReadContext:   resourceSpotinstOceanGKEExtendedResourceDefinitionRead,
+		DeleteContext: resourceSpotinstOceanGKEExtendedResourceDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: commons.OceanGKEExtendedResourceDefinitionResource.GetSchemaMap(),
+	}
+}
+
+func setupOceanGKEExtendedResourceDefinitionResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+	ocean_aws_extended_resource_definition.Setup(fieldsMap)
+
+	commons.OceanGKEExtendedResourceDefinitionResource = commons.NewOceanGKEExtendedResourceDefinitionResource(fieldsMap)
+}
+
+func resourceSpotinstOceanGKEExtendedResourceDefinitionRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readOceanExtendedResourceDefinition(commons.OceanGKEExtendedResourceDefinitionResource, resourceData, meta)
+}
+
+func resourceSpotinstOceanGKEExtendedResourceDefinitionCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate), commons.OceanGKEExtendedResourceDefinitionResource.GetName())
+
+	extendedResourceDefinition, err := commons.OceanGKEExtendedResourceDefinitionResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	extendedResourceDefinitionId, err := createOceanAWSExtendedResourceDefinition(resourceData, extendedResourceDefinition, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(extendedResourceDefinitionId))
+
+	log.Printf("===> ExtendedResourceDefinition created successfully: %s <===", resourceData.Id())
+	return resourceSpotinstOceanGKEExtendedResourceDefinitionRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstOceanGKEExtendedResourceDefinitionUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resourceId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate), commons.OceanGKEExtendedResourceDefinitionResource.GetName(), resourceId)
+
+	shouldUpdate, erd, err := commons.OceanGKEExtendedResourceDefinitionResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if shouldUpdate {
+		erd.SetId(spotinst.String(resourceId))
+		if err := updateOceanAWSExtendedResourceDefinition(erd, resourceData, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	log.Printf("===> ExtendedResourceDefinition updated successfully: %s <===", resourceId)
+	return resourceSpotinstOceanGKEExtendedResourceDefinitionRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstOceanGKEExtendedResourceDefinitionDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	resourceId := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete), commons.OceanGKEExtendedResourceDefinitionResource.GetName(), resourceId)
+
+	if err := deleteOceanAWSExtendedResourceDefinition(resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> ExtendedResourceDefinition deleted successfully: %s <===", resourceData.Id())
+	resourceData.SetId("")
+	return nil
+}
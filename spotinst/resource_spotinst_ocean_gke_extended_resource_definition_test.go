@@ -0,0 +1,127 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanGKEExtendedResourceDefinitionResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OceanGKEExtendedResourceDefinitionResourceName), name)
+}
+
+func testOceanGKEExtendedResourceDefinitionDestroy(s *terraform.State) error {
+	client := testAccProviderGCP.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OceanGKEExtendedResourceDefinitionResourceName) {
+			continue
+		}
+		input := &aws.ReadExtendedResourceDefinitionInput{ExtendedResourceDefinitionID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderAWS().ReadExtendedResourceDefinition(context.Background(), input)
+		if err == nil && resp != nil && resp.ExtendedResourceDefinition != nil {
+			return fmt.Errorf("extendedResourceDefinition still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckOceanGKEExtendedResourceDefinitionExists(erd *aws.ExtendedResourceDefinition, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		client := testAccProviderGCP.Meta().(*Client)
+		input := &aws.ReadExtendedResourceDefinitionInput{ExtendedResourceDefinitionID: spotinst.String(rs.Primary.ID)}
+		resp, err := client.ocean.CloudProviderAWS().ReadExtendedResourceDefinition(context.Background(), input)
+		if err != nil {
+			return err
+		}
+		if spotinst.StringValue(resp.ExtendedResourceDefinition.ID) != rs.Primary.Attributes["id"] {
+			return fmt.Errorf("extendedResourceDefinition not found: %+v,\n %+v\n", resp.ExtendedResourceDefinition, rs.Primary.Attributes)
+		}
+		*erd = *resp.ExtendedResourceDefinition
+		return nil
+	}
+}
+
+func createOceanGKEExtendedResourceDefinitionTerraform(name string, format string) string {
+	template :=
+		`provider "gcp" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+	template += fmt.Sprintf(format, name)
+
+	log.Printf("Terraform [%v] template:\n%v", "ocean_gke_extended_resource_definition_test", template)
+	return template
+}
+
+// region OceanGKEExtendedResourceDefinition: Baseline
+func TestAccSpotinstOceanGKEExtendedResourceDefinition_Baseline(t *testing.T) {
+	name := "test-acc-ocean_gke_extended_resource_definition_terraform_test"
+	resourceName := createOceanGKEExtendedResourceDefinitionResourceName(name)
+
+	var erd aws.ExtendedResourceDefinition
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKEExtendedResourceDefinitionDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKEExtendedResourceDefinitionTerraform(name, testBaselineOceanGKEExtendedResourceDefinitionConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExtendedResourceDefinitionExists(&erd, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "example.com/terraform-test-gke-baseline"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.n1-standard-4", "1"),
+				),
+			},
+			{
+				Config: createOceanGKEExtendedResourceDefinitionTerraform(name, testBaselineOceanGKEExtendedResourceDefinitionConfig_Update),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEExtendedResourceDefinitionExists(&erd, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "example.com/terraform-test-gke-baseline"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.n1-standard-4", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_mapping.n1-standard-8", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOceanGKEExtendedResourceDefinitionConfig_Create = `
+resource "` + string(commons.OceanGKEExtendedResourceDefinitionResourceName) + `" "%v" {
+  provider = "gcp"
+  name  = "example.com/terraform-test-gke-baseline"
+  resource_mapping = {
+    "n1-standard-4" = "1"
+  }
+}
+`
+
+const testBaselineOceanGKEExtendedResourceDefinitionConfig_Update = `
+resource "` + string(commons.OceanGKEExtendedResourceDefinitionResourceName) + `" "%v" {
+  provider = "gcp"
+  name  = "example.com/terraform-test-gke-baseline"
+  resource_mapping = {
+    "n1-standard-4" = "1"
+    "n1-standard-8" = "2"
+  }
+}
+`
+
+// endregion
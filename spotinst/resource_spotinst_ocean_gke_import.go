@@ -17,6 +17,7 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_import"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_import_autoscaler"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_import_launch_specification"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_import_logging"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_import_scheduling"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_gke_import_strategy"
 )
@@ -45,6 +46,7 @@ func setupClusterGKEImportResource() {
 	ocean_gke_import_autoscaler.Setup(fieldsMap)
 	ocean_gke_import_launch_specification.Setup(fieldsMap)
 	ocean_gke_import_strategy.Setup(fieldsMap)
+	ocean_gke_import_logging.Setup(fieldsMap)
 
 	commons.OceanGKEImportResource = commons.NewOceanGKEImportResource(fieldsMap)
 }
@@ -448,12 +448,15 @@ func TestAccSpotinstOceanGKEImport_Autoscaler(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.gpu_per_unit", "2"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.memory_per_unit", "256"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.num_of_units", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.extended_resources.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.extended_resources.example.com/fpga", "1"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.is_auto_config", "true"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.is_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.enable_automatic_and_manual_headroom", "true"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.resource_limits.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.resource_limits.0.max_memory_gib", "10"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.resource_limits.0.max_vcpu", "512"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.resource_limits.0.extended_resources.example.com/fpga", "10"),
 				),
 			},
 			{
@@ -476,6 +479,7 @@ func TestAccSpotinstOceanGKEImport_Autoscaler(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.gpu_per_unit", "3"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.memory_per_unit", "512"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.num_of_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.headroom.0.extended_resources.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.is_auto_config", "false"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.is_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "autoscaler.0.enable_automatic_and_manual_headroom", "false"),
@@ -517,6 +521,10 @@ const testOceanGKEAutoscaler_Create = `
       gpu_per_unit    = 2
       memory_per_unit = 256
       num_of_units    = 1
+
+      extended_resources = {
+        "example.com/fpga" = 1
+      }
     }
 
     down {
@@ -527,6 +535,10 @@ const testOceanGKEAutoscaler_Create = `
     resource_limits {
       max_vcpu       = 512
       max_memory_gib = 10
+
+      extended_resources = {
+        "example.com/fpga" = 10
+      }
     }
   }
 
@@ -650,3 +662,71 @@ const testStrategyGKEConfig_EmptyFields = `
 `
 
 // endregion
+
+// region Ocean GKE Import: Logging
+func TestAccSpotinstOceanGKEImport_Logging(t *testing.T) {
+	spotClusterName := "terraform-tests-do-not-delete"
+	resourceName := createOceanGKEImportResourceName(spotClusterName)
+
+	var cluster gcp.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKEImportDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				ResourceName: resourceName,
+				Config: createOceanGKEImportTerraform(&OceanGKEImportMetadata{
+					clusterName:    spotClusterName,
+					fieldsToAppend: testOceanGKELogging_Create,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEImportExists(&cluster, resourceName),
+					testCheckOceanGKEImportAttributes(&cluster, GcpClusterName),
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.0.gcs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.0.gcs.0.id", "di-5fae075b"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				Config: createOceanGKEImportTerraform(&OceanGKEImportMetadata{
+					clusterName:    spotClusterName,
+					fieldsToAppend: testOceanGKELogging_Update,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKEImportExists(&cluster, resourceName),
+					testCheckOceanGKEImportAttributes(&cluster, GcpClusterName),
+					resource.TestCheckResourceAttr(resourceName, "logging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.0.s3.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "logging.0.export.0.s3.0.id", "di-800debb6"),
+				),
+			},
+		},
+	})
+}
+
+const testOceanGKELogging_Create = `
+  logging {
+    export {
+      gcs {
+        id = "di-5fae075b"
+      }
+    }
+  }
+`
+
+const testOceanGKELogging_Update = `
+  logging {
+    export {
+      s3 {
+        id = "di-800debb6"
+      }
+    }
+  }
+`
+
+// endregion
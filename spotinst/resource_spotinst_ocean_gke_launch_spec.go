@@ -25,6 +25,7 @@ func resourceSpotinstOceanGKELaunchSpec() *schema.Resource {
 		ReadContext:   resourceSpotinstOceanGKELaunchSpecRead,
 		UpdateContext: resourceSpotinstOceanGKELaunchSpecUpdate,
 		DeleteContext: resourceSpotinstOceanGKELaunchSpecDelete,
+		CustomizeDiff: resourceSpotinstOceanGKELaunchSpecCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -34,6 +35,10 @@ func resourceSpotinstOceanGKELaunchSpec() *schema.Resource {
 	}
 }
 
+func resourceSpotinstOceanGKELaunchSpecCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return ocean_gke_launch_spec.ValidateGPU(diff)
+}
+
 func setupOceanGKELaunchSpecResource() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 
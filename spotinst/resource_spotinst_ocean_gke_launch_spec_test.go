@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -907,3 +908,223 @@ resource "` + string(commons.OceanGKELaunchSpecResourceName) + `" "%v" {
 `
 
 //endregion
+
+// region OceanGKELaunchSpec: GPU
+func TestAccSpotinstOceanGKELaunchSpec_GPU(t *testing.T) {
+	oceanID := "o-f27b341c"
+	resourceName := createOceanGKELaunchSpecResource(oceanID)
+
+	var launchSpec gcp.LaunchSpec
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKELaunchSpecDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config:      createOceanGKELaunchSpecTerraform(&GKELaunchSpecConfigMetadata{oceanID: oceanID}, testGPUOceanGKELaunchSpecConfig_InvalidMachineType),
+				ExpectError: regexp.MustCompile("can only be attached to N1 machine types"),
+			},
+			{
+				Config: createOceanGKELaunchSpecTerraform(&GKELaunchSpecConfigMetadata{oceanID: oceanID}, testGPUOceanGKELaunchSpecConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKELaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanGKELaunchSpecAttributes(&launchSpec, oceanID),
+					resource.TestCheckResourceAttr(resourceName, "gpu.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "gpu.0.type", "nvidia-tesla-t4"),
+					resource.TestCheckResourceAttr(resourceName, "gpu.0.count", "1"),
+				),
+			},
+			{
+				Config: createOceanGKELaunchSpecTerraform(&GKELaunchSpecConfigMetadata{oceanID: oceanID, updateBaselineFields: true}, testGPUOceanGKELaunchSpecConfig_Update),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKELaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanGKELaunchSpecAttributes(&launchSpec, oceanID),
+					resource.TestCheckResourceAttr(resourceName, "gpu.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "gpu.0.type", "nvidia-tesla-t4"),
+					resource.TestCheckResourceAttr(resourceName, "gpu.0.count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types.2", "custom-4-15360"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.#", "1"),
+				),
+			},
+			{
+				Config: createOceanGKELaunchSpecTerraform(&GKELaunchSpecConfigMetadata{oceanID: oceanID, updateBaselineFields: true}, testGPUOceanGKELaunchSpecConfig_EmptyFields),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKELaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanGKELaunchSpecAttributes(&launchSpec, oceanID),
+					resource.TestCheckResourceAttr(resourceName, "gpu.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testGPUOceanGKELaunchSpecConfig_InvalidMachineType = `
+resource "` + string(commons.OceanGKELaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+
+ ocean_id = "%v"
+ source_image = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+ instance_types = ["n1-standard-4", "n2-custom-4-16384"]
+
+ gpu {
+   type  = "nvidia-tesla-t4"
+   count = 1
+ }
+}
+
+`
+
+const testGPUOceanGKELaunchSpecConfig_Create = `
+resource "` + string(commons.OceanGKELaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+
+ ocean_id = "%v"
+ source_image = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+ instance_types = ["n1-standard-4", "n1-standard-8"]
+
+ gpu {
+   type  = "nvidia-tesla-t4"
+   count = 1
+ }
+}
+
+`
+
+const testGPUOceanGKELaunchSpecConfig_Update = `
+resource "` + string(commons.OceanGKELaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+
+ ocean_id = "%v"
+ source_image = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+ instance_types = ["n1-standard-4", "n1-standard-8", "custom-4-15360"]
+
+ gpu {
+   type  = "nvidia-tesla-t4"
+   count = 2
+ }
+
+ autoscale_headrooms {
+   num_of_units  = 1
+   cpu_per_unit  = 1000
+   gpu_per_unit  = 2
+   memory_per_unit = 2048
+ }
+}
+
+`
+
+const testGPUOceanGKELaunchSpecConfig_EmptyFields = `
+resource "` + string(commons.OceanGKELaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+
+ ocean_id = "%v"
+ source_image = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+ instance_types = ["n1-standard-4", "n1-standard-8"]
+}
+
+`
+
+// endregion
+
+// region OceanGKELaunchSpec: Extended Resources
+func TestAccSpotinstOceanGKELaunchSpec_ExtendedResources(t *testing.T) {
+	oceanID := "o-f27b341c"
+	resourceName := createOceanGKELaunchSpecResource(oceanID)
+	erdResourceName := createOceanGKEExtendedResourceDefinitionResourceName("fpga")
+
+	var launchSpec gcp.LaunchSpec
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "gcp") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanGKELaunchSpecDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanGKELaunchSpecTerraform(&GKELaunchSpecConfigMetadata{oceanID: oceanID}, testExtendedResourcesOceanGKELaunchSpecConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKELaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanGKELaunchSpecAttributes(&launchSpec, oceanID),
+					resource.TestCheckResourceAttr(erdResourceName, "resource_mapping.n1-standard-4", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.0.extended_resources.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.0.extended_resources.example.com/fpga", "1"),
+				),
+			},
+			{
+				Config: createOceanGKELaunchSpecTerraform(&GKELaunchSpecConfigMetadata{oceanID: oceanID, updateBaselineFields: true}, testExtendedResourcesOceanGKELaunchSpecConfig_Update),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanGKELaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanGKELaunchSpecAttributes(&launchSpec, oceanID),
+					resource.TestCheckResourceAttr(erdResourceName, "resource_mapping.n1-standard-8", "2"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.0.extended_resources.example.com/fpga", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testExtendedResourcesOceanGKELaunchSpecConfig_Create = `
+resource "` + string(commons.OceanGKEExtendedResourceDefinitionResourceName) + `" "fpga" {
+ provider = "gcp"
+
+ name = "example.com/fpga"
+ resource_mapping = {
+   "n1-standard-4" = "1"
+ }
+}
+
+resource "` + string(commons.OceanGKELaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+
+ ocean_id = "%v"
+ source_image = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+ instance_types = ["n1-standard-4"]
+
+ autoscale_headrooms {
+   num_of_units = 1
+   cpu_per_unit = 1000
+   memory_per_unit = 2048
+
+   extended_resources = {
+     (` + string(commons.OceanGKEExtendedResourceDefinitionResourceName) + `.fpga.name) = 1
+   }
+ }
+}
+
+`
+
+const testExtendedResourcesOceanGKELaunchSpecConfig_Update = `
+resource "` + string(commons.OceanGKEExtendedResourceDefinitionResourceName) + `" "fpga" {
+ provider = "gcp"
+
+ name = "example.com/fpga"
+ resource_mapping = {
+   "n1-standard-4" = "1"
+   "n1-standard-8" = "2"
+ }
+}
+
+resource "` + string(commons.OceanGKELaunchSpecResourceName) + `" "%v" {
+ provider = "%v"
+
+ ocean_id = "%v"
+ source_image = "https://www.googleapis.com/compute/v1/projects/gke-node-images/global/images/gke-1118-gke6-cos-69-10895-138-0-v190330-pre"
+ instance_types = ["n1-standard-4", "n1-standard-8"]
+
+ autoscale_headrooms {
+   num_of_units = 1
+   cpu_per_unit = 1000
+   memory_per_unit = 2048
+
+   extended_resources = {
+     (` + string(commons.OceanGKEExtendedResourceDefinitionResourceName) + `.fpga.name) = 2
+   }
+ }
+}
+
+`
+
+// endregion
@@ -18,6 +18,7 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_spark_compute"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_spark_ingress"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_spark_log_collection"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_spark_spark"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_spark_webhook"
 )
 
@@ -49,6 +50,7 @@ func setupSparkClusterResource() {
 	ocean_spark_webhook.Setup(fieldsMap)
 	ocean_spark_compute.Setup(fieldsMap)
 	ocean_spark_log_collection.Setup(fieldsMap)
+	ocean_spark_spark.Setup(fieldsMap)
 
 	commons.OceanSparkResource = commons.NewOceanSparkResource(fieldsMap)
 }
@@ -69,6 +71,13 @@ func resourceSpotinstSparkClusterCreate(ctx context.Context, resourceData *schem
 
 	resourceData.SetId(spotinst.StringValue(clusterID))
 
+	if wait, ok := resourceData.GetOk(string(ocean_spark.WaitForReady)); ok && wait.(bool) {
+		timeout := resourceData.Get(string(ocean_spark.WaitForReadyTimeout)).(int)
+		if err := awaitSparkClusterReady(ctx, clusterID, timeout, meta.(*Client)); err != nil {
+			return diag.Errorf("[ERROR] Cluster not ready: %s", err)
+		}
+	}
+
 	log.Printf("===> Cluster created successfully: %s <===", resourceData.Id())
 	return resourceSpotinstSparkClusterRead(ctx, resourceData, meta)
 }
@@ -102,6 +111,28 @@ func createSparkCluster(cluster *spark.Cluster, spotinstClient *Client) (*string
 	return resp.Cluster.ID, nil
 }
 
+func awaitSparkClusterReady(ctx context.Context, clusterID *string, timeout int, spotinstClient *Client) error {
+	description := fmt.Sprintf("cluster [%v] to be ready", spotinst.StringValue(clusterID))
+	return commons.AwaitState(ctx, description, timeout, func() (string, bool, error) {
+		input := &spark.ReadClusterInput{ClusterID: clusterID}
+		resp, err := spotinstClient.ocean.Spark().ReadCluster(ctx, input)
+		if err != nil {
+			return "", false, fmt.Errorf("[ERROR] awaitSparkClusterReady() -> readCluster [%v] API call failed, error: %v", spotinst.StringValue(clusterID), err)
+		}
+
+		state, lastError := "", ""
+		if resp.Cluster != nil {
+			state = spotinst.StringValue(resp.Cluster.State)
+			lastError = spotinst.StringValue(resp.Cluster.LastError)
+		}
+
+		if state == ocean_spark.StateFailed {
+			return state, false, fmt.Errorf("cluster [%v] failed to deploy: %s", spotinst.StringValue(clusterID), lastError)
+		}
+		return state, state == ocean_spark.StateAvailable, nil
+	})
+}
+
 func resourceSpotinstSparkClusterRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead),
@@ -155,6 +186,13 @@ func resourceSpotinstSparkClusterUpdate(ctx context.Context, resourceData *schem
 		if err := updateSparkCluster(cluster, meta); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if wait, ok := resourceData.GetOk(string(ocean_spark.WaitForReady)); ok && wait.(bool) {
+			timeout := resourceData.Get(string(ocean_spark.WaitForReadyTimeout)).(int)
+			if err := awaitSparkClusterReady(ctx, cluster.ID, timeout, meta.(*Client)); err != nil {
+				return diag.Errorf("[ERROR] Cluster not ready: %s", err)
+			}
+		}
 	}
 
 	log.Printf("===> Cluster updated successfully: %s <===", id)
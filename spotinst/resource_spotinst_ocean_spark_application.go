@@ -0,0 +1,185 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/spark"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_spark_application"
+)
+
+func resourceSpotinstOceanSparkApplication() *schema.Resource {
+	setupSparkApplicationResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstSparkApplicationCreate,
+		ReadContext:   resourceSpotinstSparkApplicationRead,
+		UpdateContext: resourceSpotinstSparkApplicationUpdate,
+		DeleteContext: resourceSpotinstSparkApplicationDelete,
+
+		Schema: commons.OceanSparkApplicationResource.GetSchemaMap(),
+	}
+}
+
+func setupSparkApplicationResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	ocean_spark_application.Setup(fieldsMap)
+
+	commons.OceanSparkApplicationResource = commons.NewOceanSparkApplicationResource(fieldsMap)
+}
+
+func resourceSpotinstSparkApplicationCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.OceanSparkApplicationResource.GetName())
+
+	app, err := commons.OceanSparkApplicationResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if json, err := commons.ToJson(app); err != nil {
+		return diag.FromErr(err)
+	} else {
+		log.Printf("===> Application submit configuration: %s", json)
+	}
+
+	input := &spark.CreateApplicationInput{
+		ClusterID: app.ClusterID,
+		Application: &spark.CreateApplicationRequest{
+			JobID:           app.JobID,
+			AppName:         app.AppName,
+			ConfigOverrides: app.ConfigOverrides,
+		},
+	}
+	resp, err := meta.(*Client).ocean.Spark().CreateApplication(ctx, input)
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to submit application: %s", err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(resp.Application.ID))
+	log.Printf("===> Application submitted successfully: %s <===", resourceData.Id())
+
+	if wait, ok := resourceData.GetOk(string(ocean_spark_application.WaitForCompletion)); ok && wait.(bool) {
+		timeout := resourceData.Get(string(ocean_spark_application.WaitForCompletionTimeout)).(int)
+		if err := awaitSparkApplicationTerminal(ctx, app.ClusterID, resp.Application.ID, timeout, meta.(*Client)); err != nil {
+			// Keep the final state and log URL so the failure can be inspected.
+			return append(resourceSpotinstSparkApplicationRead(ctx, resourceData, meta), diag.FromErr(err)...)
+		}
+	}
+
+	return resourceSpotinstSparkApplicationRead(ctx, resourceData, meta)
+}
+
+// awaitSparkApplicationTerminal waits for the application to reach a terminal
+// state and fails unless it completed successfully.
+func awaitSparkApplicationTerminal(ctx context.Context, clusterID, appID *string, timeout int, spotinstClient *Client) error {
+	if timeout == 0 {
+		return nil
+	}
+
+	var app *spark.Application
+	description := fmt.Sprintf("application [%v] to finish", spotinst.StringValue(appID))
+	err := commons.AwaitState(ctx, description, timeout, func() (string, bool, error) {
+		input := &spark.ReadApplicationInput{ClusterID: clusterID, ApplicationID: appID}
+		resp, err := spotinstClient.ocean.Spark().ReadApplication(ctx, input)
+		if err != nil {
+			return "", false, fmt.Errorf("[ERROR] awaitSparkApplicationTerminal() -> readApplication [%v] API call failed, error: %v", spotinst.StringValue(appID), err)
+		}
+
+		app = resp.Application
+		state := ""
+		if app != nil {
+			state = spotinst.StringValue(app.AppState)
+		}
+		return state, ocean_spark_application.IsTerminalState(state), nil
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Application did not finish: %s", err)
+	}
+
+	if state := spotinst.StringValue(app.AppState); state != ocean_spark_application.StateCompleted {
+		return fmt.Errorf("[ERROR] Application [%v] finished in state %q, see logs at %s",
+			spotinst.StringValue(appID), state, spotinst.StringValue(app.LogURL))
+	}
+
+	return nil
+}
+
+func resourceSpotinstSparkApplicationRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.OceanSparkApplicationResource.GetName(), id)
+
+	input := &spark.ReadApplicationInput{
+		ClusterID:     spotinst.String(resourceData.Get(string(ocean_spark_application.OceanSparkClusterID)).(string)),
+		ApplicationID: spotinst.String(id),
+	}
+	resp, err := meta.(*Client).ocean.Spark().ReadApplication(ctx, input)
+	if err != nil {
+		// If the application was not found, return nil so that we can show
+		// that the application does not exist
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeResourceDoesNotExist {
+					resourceData.SetId("")
+					return nil
+				}
+			}
+		}
+
+		// Some other error, report it.
+		return diag.Errorf("failed to read application: %s", err)
+	}
+
+	// if nothing was found, return no state
+	app := resp.Application
+	if app == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	if err := commons.OceanSparkApplicationResource.OnRead(app, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Application read successfully: %s <===", id)
+	return nil
+}
+
+// resourceSpotinstSparkApplicationUpdate only handles the wait settings; every
+// other argument submits a new application.
+func resourceSpotinstSparkApplicationUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceSpotinstSparkApplicationRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstSparkApplicationDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.OceanSparkApplicationResource.GetName(), id)
+
+	// Finished applications are kept by the cluster for their history and
+	// logs, only running ones are killed.
+	state := resourceData.Get(string(ocean_spark_application.State)).(string)
+	if !ocean_spark_application.IsTerminalState(state) {
+		input := &spark.DeleteApplicationInput{
+			ClusterID:     spotinst.String(resourceData.Get(string(ocean_spark_application.OceanSparkClusterID)).(string)),
+			ApplicationID: spotinst.String(id),
+		}
+		if _, err := meta.(*Client).ocean.Spark().DeleteApplication(ctx, input); err != nil {
+			return diag.Errorf("[ERROR] onDelete() -> Failed to kill application: %s", err)
+		}
+	}
+
+	log.Printf("===> Application deleted successfully: %s <===", id)
+	resourceData.SetId("")
+
+	return nil
+}
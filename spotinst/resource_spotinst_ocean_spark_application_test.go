@@ -0,0 +1,112 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/spark"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_spark_application"
+)
+
+func createOceanSparkApplicationResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OceanSparkApplicationResourceName), name)
+}
+
+func testOceanSparkApplicationDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OceanSparkApplicationResourceName) {
+			continue
+		}
+		input := &spark.ReadApplicationInput{
+			ClusterID:     spotinst.String(rs.Primary.Attributes["ocean_spark_id"]),
+			ApplicationID: spotinst.String(rs.Primary.ID),
+		}
+		resp, err := client.ocean.Spark().ReadApplication(context.Background(), input)
+		if err == nil && resp != nil && resp.Application != nil &&
+			!ocean_spark_application.IsTerminalState(spotinst.StringValue(resp.Application.AppState)) {
+			return fmt.Errorf("application still running")
+		}
+	}
+	return testOceanSparkAWSDestroy(s)
+}
+
+func createOceanSparkApplicationTerraform(sccm *SparkClusterConfigMetadata, appName string, fieldsToAppend string) string {
+	template := createOceanSparkTerraform(sccm) + fmt.Sprintf(testSparkApplicationConfig,
+		appName,
+		createOceanSparkResourceName(sccm.oceanClusterID),
+		appName,
+		fieldsToAppend,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", appName, template)
+	return template
+}
+
+const testSparkApplicationConfig = `
+resource "` + string(commons.OceanSparkApplicationResourceName) + `" "%v" {
+  provider = "aws"
+
+  ocean_spark_id = %v.id
+  app_name       = "%v"
+
+  %v
+}
+`
+
+func TestAccSpotinstOceanSparkApplication_SparkPi(t *testing.T) {
+	appName := "tf-test-acc-spark-pi"
+	resourceName := createOceanSparkApplicationResourceName(appName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanSparkApplicationDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanSparkApplicationTerraform(&SparkClusterConfigMetadata{
+					oceanClusterID: oceanClusterID,
+					fieldsToAppend: testConfigWithWaitForReady,
+				}, appName, testSparkApplicationSparkPi),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "ocean_spark_id", createOceanSparkResourceName(oceanClusterID), "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "COMPLETED"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_url"),
+				),
+			},
+		},
+	})
+}
+
+const testSparkApplicationSparkPi = `
+  type                  = "Scala"
+  spark_version         = "3.2.2"
+  image                 = "gcr.io/datamechanics/spark:platform-3.2-latest"
+  main_class            = "org.apache.spark.examples.SparkPi"
+  main_application_file = "local:///opt/spark/examples/jars/examples.jar"
+  arguments             = ["1000"]
+
+  driver {
+    cores  = 1
+    memory = "1g"
+  }
+
+  executor {
+    cores     = 1
+    memory    = "1g"
+    instances = 1
+  }
+
+  spark_conf = {
+    "spark.dynamicAllocation.enabled" = "false"
+  }
+`
@@ -447,6 +447,74 @@ func TestAccSpotinstOceanSpark_withLogCollectionConfig(t *testing.T) {
 	})
 }
 
+func TestAccSpotinstOceanSpark_withSparkConfig(t *testing.T) {
+	resourceName := createOceanSparkResourceName(oceanClusterID)
+
+	var cluster spark.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanSparkAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanSparkTerraform(&SparkClusterConfigMetadata{
+					oceanClusterID: oceanClusterID,
+					fieldsToAppend: testConfigWithSparkCreate,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanSparkExists(&cluster, resourceName),
+					testCheckOceanSparkAttributes(&cluster, oceanClusterID),
+					resource.TestCheckResourceAttr(resourceName, "spark.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spark.0.default_spark_version", "3.2.2"),
+					resource.TestCheckResourceAttr(resourceName, "spark.0.app_namespaces.#", "1"),
+				),
+			},
+			{
+				Config: createOceanSparkTerraform(&SparkClusterConfigMetadata{
+					oceanClusterID: oceanClusterID,
+					fieldsToAppend: testConfigWithSparkUpdate,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanSparkExists(&cluster, resourceName),
+					testCheckOceanSparkAttributes(&cluster, oceanClusterID),
+					resource.TestCheckResourceAttr(resourceName, "spark.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spark.0.default_spark_version", "3.3.0"),
+					resource.TestCheckResourceAttr(resourceName, "spark.0.app_namespaces.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSpotinstOceanSpark_withWaitForReady(t *testing.T) {
+	resourceName := createOceanSparkResourceName(oceanClusterID)
+
+	var cluster spark.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanSparkAWSDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanSparkTerraform(&SparkClusterConfigMetadata{
+					oceanClusterID: oceanClusterID,
+					fieldsToAppend: testConfigWithWaitForReady,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanSparkExists(&cluster, resourceName),
+					testCheckOceanSparkAttributes(&cluster, oceanClusterID),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_ready", "true"),
+					resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(resourceName, "operator_version"),
+					resource.TestCheckResourceAttr(resourceName, "last_error", ""),
+				),
+			},
+		},
+	})
+}
+
 const testConfigWithIngressCreate = `
  ingress {
 
@@ -644,3 +712,26 @@ const testConfigWithLogCollectionUpdate = `
 
  }
 `
+
+const testConfigWithSparkCreate = `
+ spark {
+
+    default_spark_version = "3.2.2"
+    app_namespaces        = ["spark-apps"]
+
+ }
+`
+
+const testConfigWithSparkUpdate = `
+ spark {
+
+    default_spark_version = "3.3.0"
+    app_namespaces        = ["spark-apps", "spark-apps-2"]
+
+ }
+`
+
+const testConfigWithWaitForReady = `
+ wait_for_ready         = true
+ wait_for_ready_timeout = 1800
+`
@@ -0,0 +1,161 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/spark"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_spark_virtual_node_group"
+)
+
+func resourceSpotinstOceanSparkVirtualNodeGroup() *schema.Resource {
+	setupSparkVirtualNodeGroupResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstSparkVirtualNodeGroupCreate,
+		ReadContext:   resourceSpotinstSparkVirtualNodeGroupRead,
+		DeleteContext: resourceSpotinstSparkVirtualNodeGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importSparkVirtualNodeGroup,
+		},
+		Schema: commons.OceanSparkVirtualNodeGroupResource.GetSchemaMap(),
+	}
+}
+
+func setupSparkVirtualNodeGroupResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	ocean_spark_virtual_node_group.Setup(fieldsMap)
+
+	commons.OceanSparkVirtualNodeGroupResource = commons.NewOceanSparkVirtualNodeGroupResource(fieldsMap)
+}
+
+// importSparkVirtualNodeGroup imports an attachment using an ID of the form
+// `<ocean_spark_id>:<virtual_node_group_id>`.
+func importSparkVirtualNodeGroup(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(resourceData.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected <ocean_spark_id>:<virtual_node_group_id>", resourceData.Id())
+	}
+
+	if err := resourceData.Set(string(ocean_spark_virtual_node_group.OceanSparkClusterID), parts[0]); err != nil {
+		return nil, err
+	}
+	resourceData.SetId(parts[1])
+
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+func resourceSpotinstSparkVirtualNodeGroupCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.OceanSparkVirtualNodeGroupResource.GetName())
+
+	vng, err := commons.OceanSparkVirtualNodeGroupResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &spark.AttachVngInput{
+		ClusterID: vng.OceanSparkClusterID,
+		VngID:     vng.VngID,
+	}
+
+	if json, err := commons.ToJson(input); err != nil {
+		return diag.FromErr(err)
+	} else {
+		log.Printf("===> Virtual node group attach configuration: %s", json)
+	}
+
+	if _, err := meta.(*Client).ocean.Spark().AttachVirtualNodeGroup(ctx, input); err != nil {
+		return diag.Errorf("[ERROR] failed to attach virtual node group: %s", err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(vng.VngID))
+
+	log.Printf("===> Virtual node group attached successfully: %s <===", resourceData.Id())
+	return resourceSpotinstSparkVirtualNodeGroupRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstSparkVirtualNodeGroupRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.OceanSparkVirtualNodeGroupResource.GetName(), id)
+
+	clusterID := resourceData.Get(string(ocean_spark_virtual_node_group.OceanSparkClusterID)).(string)
+	input := &spark.ListVngsInput{ClusterID: spotinst.String(clusterID)}
+	resp, err := meta.(*Client).ocean.Spark().ListVirtualNodeGroups(ctx, input)
+	if err != nil {
+		// If the cluster was not found, return nil so that we can show
+		// that the attachment does not exist
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeResourceDoesNotExist {
+					resourceData.SetId("")
+					return nil
+				}
+			}
+		}
+
+		// Some other error, report it.
+		return diag.Errorf("failed to read virtual node groups: %s", err)
+	}
+
+	var vng *spark.DedicatedVirtualNodeGroup
+	for _, v := range resp.VirtualNodeGroups {
+		if spotinst.StringValue(v.VngID) == id {
+			vng = v
+			break
+		}
+	}
+
+	// if nothing was found, return no state
+	if vng == nil {
+		resourceData.SetId("")
+		return nil
+	}
+	if vng.OceanSparkClusterID == nil {
+		vng.OceanSparkClusterID = spotinst.String(clusterID)
+	}
+
+	if err := commons.OceanSparkVirtualNodeGroupResource.OnRead(vng, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Virtual node group read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstSparkVirtualNodeGroupDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.OceanSparkVirtualNodeGroupResource.GetName(), id)
+
+	input := &spark.DetachVngInput{
+		ClusterID: spotinst.String(resourceData.Get(string(ocean_spark_virtual_node_group.OceanSparkClusterID)).(string)),
+		VngID:     spotinst.String(id),
+	}
+
+	if json, err := commons.ToJson(input); err != nil {
+		return diag.FromErr(err)
+	} else {
+		log.Printf("===> Virtual node group detach configuration: %s", json)
+	}
+
+	if _, err := meta.(*Client).ocean.Spark().DetachVirtualNodeGroup(ctx, input); err != nil {
+		return diag.Errorf("[ERROR] onDelete() -> Failed to detach virtual node group: %s", err)
+	}
+
+	log.Printf("===> Virtual node group detached successfully: %s <===", id)
+	resourceData.SetId("")
+
+	return nil
+}
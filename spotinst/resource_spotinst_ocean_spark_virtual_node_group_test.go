@@ -0,0 +1,99 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/spark"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+const (
+	testOceanSparkVirtualNodeGroupID = "ols-0b6b5bd7"
+)
+
+var oceanSparkVirtualNodeGroupID = getOceanSparkVirtualNodeGroupID() // NOTE: This needs to be an existing VNG of the ocean cluster
+
+func getOceanSparkVirtualNodeGroupID() string {
+	// Prefer environment variable
+	vngID := os.Getenv("TEST_ACC_OCEAN_SPARK_VNG_ID")
+	if vngID == "" {
+		// Default to hardcoded ID
+		vngID = testOceanSparkVirtualNodeGroupID
+	}
+
+	return vngID
+}
+
+func createOceanSparkVirtualNodeGroupResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OceanSparkVirtualNodeGroupResourceName), name)
+}
+
+func testOceanSparkVirtualNodeGroupDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OceanSparkVirtualNodeGroupResourceName) {
+			continue
+		}
+		input := &spark.ListVngsInput{ClusterID: spotinst.String(rs.Primary.Attributes["ocean_spark_id"])}
+		resp, err := client.ocean.Spark().ListVirtualNodeGroups(context.Background(), input)
+		if err != nil || resp == nil {
+			continue
+		}
+		for _, vng := range resp.VirtualNodeGroups {
+			if spotinst.StringValue(vng.VngID) == rs.Primary.ID {
+				return fmt.Errorf("virtual node group still attached")
+			}
+		}
+	}
+	return testOceanSparkAWSDestroy(s)
+}
+
+func createOceanSparkVirtualNodeGroupTerraform(sccm *SparkClusterConfigMetadata, vngID string) string {
+	template := createOceanSparkTerraform(sccm) + fmt.Sprintf(testSparkVirtualNodeGroupConfig,
+		sccm.oceanClusterID,
+		createOceanSparkResourceName(sccm.oceanClusterID),
+		vngID,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", vngID, template)
+	return template
+}
+
+const testSparkVirtualNodeGroupConfig = `
+resource "` + string(commons.OceanSparkVirtualNodeGroupResourceName) + `" "%v" {
+  provider = "aws"
+
+  ocean_spark_id        = %v.id
+  virtual_node_group_id = "%v"
+}
+`
+
+func TestAccSpotinstOceanSparkVirtualNodeGroup(t *testing.T) {
+	resourceName := createOceanSparkVirtualNodeGroupResourceName(oceanClusterID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanSparkVirtualNodeGroupDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanSparkVirtualNodeGroupTerraform(&SparkClusterConfigMetadata{
+					oceanClusterID: oceanClusterID,
+				}, oceanSparkVirtualNodeGroupID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "ocean_spark_id", createOceanSparkResourceName(oceanClusterID), "id"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_node_group_id", oceanSparkVirtualNodeGroupID),
+				),
+			},
+		},
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -15,6 +17,10 @@ import (
 	subscriptionPackage "github.com/spotinst/terraform-provider-spotinst/spotinst/subscription"
 )
 
+// subscriptionIdSeparator separates the underlying subscription IDs of a
+// resource that manages more than one event type.
+const subscriptionIdSeparator = ","
+
 func resourceSpotinstSubscription() *schema.Resource {
 	setupSubscription()
 	return &schema.Resource{
@@ -23,6 +29,8 @@ func resourceSpotinstSubscription() *schema.Resource {
 		ReadContext:   resourceSpotinstSubscriptionRead,
 		DeleteContext: resourceSpotinstSubscriptionDelete,
 
+		CustomizeDiff: resourceSpotinstSubscriptionCustomizeDiff,
+
 		Schema: commons.SubscriptionResource.GetSchemaMap(),
 	}
 }
@@ -35,42 +43,139 @@ func setupSubscription() {
 	commons.SubscriptionResource = commons.NewSubscriptionResource(fieldsMap)
 }
 
+func resourceSpotinstSubscriptionCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	_, hasEventType := diff.GetOk(string(subscriptionPackage.EventType))
+	_, hasEventTypes := diff.GetOk(string(subscriptionPackage.EventTypes))
+	if !hasEventType && !hasEventTypes && diff.NewValueKnown(string(subscriptionPackage.EventTypes)) {
+		return fmt.Errorf("one of %q or %q must be configured", subscriptionPackage.EventType, subscriptionPackage.EventTypes)
+	}
+
+	if v, ok := diff.GetOk(string(subscriptionPackage.SigningSecret)); ok && v.(string) != "" {
+		protocol := diff.Get(string(subscriptionPackage.Protocol)).(string)
+		if diff.NewValueKnown(string(subscriptionPackage.Protocol)) && !subscriptionPackage.SupportsSigning(protocol) {
+			return fmt.Errorf("%q is only supported with the %s protocols, got %q",
+				subscriptionPackage.SigningSecret, strings.Join(subscriptionPackage.SigningProtocols, ", "), protocol)
+		}
+	}
+
+	return nil
+}
+
+func subscriptionIds(resourceData *schema.ResourceData) []string {
+	return strings.Split(resourceData.Id(), subscriptionIdSeparator)
+}
+
+// subscriptionIdsByEventType returns the underlying subscription ID per event
+// type, falling back to the resource ID for states written before
+// `subscription_ids` existed.
+func subscriptionIdsByEventType(resourceData *schema.ResourceData) map[string]string {
+	out := make(map[string]string)
+	if v, ok := resourceData.GetOk(string(subscriptionPackage.SubscriptionIds)); ok {
+		for eventType, id := range v.(map[string]interface{}) {
+			out[eventType] = id.(string)
+		}
+	}
+
+	if len(out) == 0 {
+		old, _ := resourceData.GetChange(string(subscriptionPackage.EventType))
+		if eventType, ok := old.(string); ok && eventType != "" {
+			out[strings.ToUpper(eventType)] = resourceData.Id()
+		}
+	}
+	return out
+}
+
+func setSubscriptionIds(resourceData *schema.ResourceData, idsByEventType map[string]string) error {
+	eventTypes := make([]string, 0, len(idsByEventType))
+	for eventType := range idsByEventType {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+
+	ids := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		ids = append(ids, idsByEventType[eventType])
+	}
+
+	resourceData.SetId(strings.Join(ids, subscriptionIdSeparator))
+	if err := resourceData.Set(string(subscriptionPackage.SubscriptionIds), idsByEventType); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(subscriptionPackage.SubscriptionIds), err)
+	}
+	return nil
+}
+
 func resourceSpotinstSubscriptionDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.SubscriptionResource.GetName(), id)
 
-	input := &subscription.DeleteSubscriptionInput{SubscriptionID: spotinst.String(id)}
-	if _, err := meta.(*Client).subscription.Delete(context.Background(), input); err != nil {
-		return diag.Errorf("[ERROR] Failed to delete subscription: %s", err)
+	for _, subscriptionId := range subscriptionIds(resourceData) {
+		if err := deleteSubscription(subscriptionId, meta.(*Client)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	resourceData.SetId("")
 	return nil
 }
 
+func deleteSubscription(subscriptionId string, spotinstClient *Client) error {
+	input := &subscription.DeleteSubscriptionInput{SubscriptionID: spotinst.String(subscriptionId)}
+	if _, err := spotinstClient.subscription.Delete(context.Background(), input); err != nil {
+		return fmt.Errorf("[ERROR] Failed to delete subscription %s: %s", subscriptionId, err)
+	}
+	return nil
+}
+
 func resourceSpotinstSubscriptionRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead),
 		commons.SubscriptionResource.GetName(), id)
 
 	client := meta.(*Client)
-	input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(resourceData.Id())}
-	subResponse, err := client.subscription.Read(context.Background(), input)
-	if err != nil {
-		return diag.Errorf("[ERROR] Failed to read subscription: %s", err)
+	var first *subscription.Subscription
+	idsByEventType := make(map[string]string)
+	for _, subscriptionId := range subscriptionIds(resourceData) {
+		input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(subscriptionId)}
+		subResponse, err := client.subscription.Read(context.Background(), input)
+		if err != nil {
+			return diag.Errorf("[ERROR] Failed to read subscription: %s", err)
+		}
+
+		sub := subResponse.Subscription
+		if sub == nil {
+			continue
+		}
+		if first == nil {
+			first = sub
+		}
+		idsByEventType[strings.ToUpper(spotinst.StringValue(sub.EventType))] = subscriptionId
 	}
 
 	// If nothing was found, then return no state.
-	sub := subResponse.Subscription
-	if sub == nil {
+	if first == nil {
 		resourceData.SetId("")
 		return nil
 	}
 
-	if err := commons.SubscriptionResource.OnRead(sub, resourceData, meta); err != nil {
+	if err := commons.SubscriptionResource.OnRead(first, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	if _, ok := resourceData.GetOk(string(subscriptionPackage.EventTypes)); ok {
+		eventTypes := make([]string, 0, len(idsByEventType))
+		for eventType := range idsByEventType {
+			eventTypes = append(eventTypes, eventType)
+		}
+		if err := resourceData.Set(string(subscriptionPackage.EventTypes), eventTypes); err != nil {
+			return diag.Errorf(string(commons.FailureFieldReadPattern), string(subscriptionPackage.EventTypes), err)
+		}
+	}
+
+	if err := setSubscriptionIds(resourceData, idsByEventType); err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("===> Subscription read successfully: %s <===", id)
 	return nil
 }
@@ -84,17 +189,35 @@ func resourceSpotinstSubscriptionCreate(ctx context.Context, resourceData *schem
 		return diag.FromErr(err)
 	}
 
-	subscriptionId, err := createSubscription(sub, meta.(*Client))
-	if err != nil {
-		return diag.FromErr(err)
+	idsByEventType := make(map[string]string)
+	for _, eventType := range subscriptionPackage.ExpandEventTypes(resourceData) {
+		subscriptionId, err := createSubscriptionForEventType(sub, eventType, meta.(*Client))
+		if err != nil {
+			// Keep track of the subscriptions created so far so they are not leaked.
+			if len(idsByEventType) > 0 {
+				if setErr := setSubscriptionIds(resourceData, idsByEventType); setErr != nil {
+					log.Printf("[ERROR] failed to store partially created subscriptions: %s", setErr)
+				}
+			}
+			return diag.FromErr(err)
+		}
+		idsByEventType[eventType] = spotinst.StringValue(subscriptionId)
 	}
 
-	resourceData.SetId(spotinst.StringValue(subscriptionId))
+	if err := setSubscriptionIds(resourceData, idsByEventType); err != nil {
+		return diag.FromErr(err)
+	}
 	log.Printf("===> Subscription created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstSubscriptionRead(ctx, resourceData, meta)
 }
 
+func createSubscriptionForEventType(template *subscription.Subscription, eventType string, spotinstClient *Client) (*string, error) {
+	subObj := *template
+	subObj.SetEventType(spotinst.String(eventType))
+	return createSubscription(&subObj, spotinstClient)
+}
+
 func createSubscription(subObj *subscription.Subscription, spotinstClient *Client) (*string, error) {
 	input := &subscription.CreateSubscriptionInput{Subscription: subObj}
 	resp, err := spotinstClient.subscription.Create(context.Background(), input)
@@ -109,22 +232,68 @@ func resourceSpotinstSubscriptionUpdate(ctx context.Context, resourceData *schem
 	log.Printf(string(commons.ResourceOnUpdate),
 		commons.SubscriptionResource.GetName(), id)
 
+	if resourceData.HasChange(string(subscriptionPackage.EventTypes)) {
+		if err := reconcileSubscriptionEventTypes(resourceData, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	shouldUpdate, sub, err := commons.SubscriptionResource.OnUpdate(resourceData, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if shouldUpdate {
-		sub.SetId(spotinst.String(id))
-		if err := updateSubscription(sub, resourceData, meta); err != nil {
-			return diag.FromErr(err)
+		for _, subscriptionId := range subscriptionIds(resourceData) {
+			subObj := *sub
+			subObj.SetId(spotinst.String(subscriptionId))
+			if err := updateSubscription(&subObj, resourceData, meta); err != nil {
+				return diag.FromErr(err)
+			}
 		}
 	}
 
-	log.Printf("===> Subscription updated successfully: %s <===", id)
+	log.Printf("===> Subscription updated successfully: %s <===", resourceData.Id())
 	return resourceSpotinstSubscriptionRead(ctx, resourceData, meta)
 }
 
+// reconcileSubscriptionEventTypes creates a subscription for every added event
+// type and deletes the subscription of every removed one.
+func reconcileSubscriptionEventTypes(resourceData *schema.ResourceData, meta interface{}) error {
+	current := subscriptionIdsByEventType(resourceData)
+	desired := subscriptionPackage.ExpandEventTypes(resourceData)
+
+	template, err := commons.SubscriptionResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	result := make(map[string]string)
+	for _, eventType := range desired {
+		if subscriptionId, ok := current[eventType]; ok {
+			result[eventType] = subscriptionId
+			continue
+		}
+
+		subscriptionId, err := createSubscriptionForEventType(template, eventType, meta.(*Client))
+		if err != nil {
+			return err
+		}
+		result[eventType] = spotinst.StringValue(subscriptionId)
+	}
+
+	for eventType, subscriptionId := range current {
+		if _, ok := result[eventType]; ok {
+			continue
+		}
+		if err := deleteSubscription(subscriptionId, meta.(*Client)); err != nil {
+			return err
+		}
+	}
+
+	return setSubscriptionIds(resourceData, result)
+}
+
 func updateSubscription(sub *subscription.Subscription, resourceData *schema.ResourceData, meta interface{}) error {
 	input := &subscription.UpdateSubscriptionInput{
 		Subscription: sub,
@@ -137,7 +306,7 @@ func updateSubscription(sub *subscription.Subscription, resourceData *schema.Res
 	}
 
 	if _, err := meta.(*Client).subscription.Update(context.Background(), input); err != nil {
-		return fmt.Errorf("[ERROR] failed to update subscription %s: %s", resourceData.Id(), err)
+		return fmt.Errorf("[ERROR] failed to update subscription %s: %s", spotinst.StringValue(sub.ID), err)
 	}
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -24,10 +25,12 @@ func testSubscriptionDestroy(s *terraform.State) error {
 		if rs.Type != string(commons.SubscriptionResourceName) {
 			continue
 		}
-		input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(rs.Primary.ID)}
-		resp, err := client.subscription.Read(context.Background(), input)
-		if err == nil && resp != nil && resp.Subscription != nil {
-			return fmt.Errorf("subscription still exists")
+		for _, id := range strings.Split(rs.Primary.ID, subscriptionIdSeparator) {
+			input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(id)}
+			resp, err := client.subscription.Read(context.Background(), input)
+			if err == nil && resp != nil && resp.Subscription != nil {
+				return fmt.Errorf("subscription still exists")
+			}
 		}
 	}
 	return nil
@@ -43,12 +46,13 @@ func testCheckSubscriptionExists(sub *subscription.Subscription, resourceName st
 			return fmt.Errorf("no resource ID is set")
 		}
 		client := testAccProviderAWS.Meta().(*Client)
-		input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(rs.Primary.ID)}
+		id := strings.Split(rs.Primary.ID, subscriptionIdSeparator)[0]
+		input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(id)}
 		resp, err := client.subscription.Read(context.Background(), input)
 		if err != nil {
 			return err
 		}
-		if spotinst.StringValue(resp.Subscription.EventType) != rs.Primary.Attributes["event_type"] {
+		if eventType := rs.Primary.Attributes["event_type"]; eventType != "" && spotinst.StringValue(resp.Subscription.EventType) != eventType {
 			return fmt.Errorf("Subscription not found: %+v,\n %+v\n", resp.Subscription, rs.Primary.Attributes)
 		}
 		*sub = *resp.Subscription
@@ -429,3 +433,95 @@ resource "` + string(commons.SubscriptionResourceName) + `" "%v" {
 `
 
 // endregion
+
+// region Subscription: Multiple Event Types
+func TestAccSpotinstSubscription_EventTypes(t *testing.T) {
+	subscriptionName := "subscription-event-types"
+	subResourceName := createSubscriptionResourceName(subscriptionName)
+
+	groupName := "eg-baseline"
+	groupResourceName := createElastigroupResourceName(groupName)
+	groupResourceId := "${" + groupResourceName + ".id}"
+	groupTerraform := createElastigroupTerraform(&GroupConfigMetadata{groupName: groupName})
+
+	var group aws.Group
+	var sub subscription.Subscription
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testSubscriptionDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createSubscriptionTerraform(testSubscription_EventTypes_Create, subscriptionName, groupResourceId, groupTerraform),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, groupResourceName),
+					testCheckElastigroupAttributes(&group, groupName),
+
+					testCheckSubscriptionExists(&sub, subResourceName),
+					resource.TestCheckResourceAttr(subResourceName, "event_types.#", "2"),
+					resource.TestCheckResourceAttr(subResourceName, "subscription_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(subResourceName, "subscription_ids.AWS_EC2_INSTANCE_LAUNCH"),
+					resource.TestCheckResourceAttrSet(subResourceName, "subscription_ids.AWS_EC2_INSTANCE_TERMINATE"),
+					resource.TestCheckResourceAttr(subResourceName, "format.%", "2"),
+					resource.TestCheckResourceAttr(subResourceName, "format.instanceId", "%instance-id%"),
+					resource.TestCheckResourceAttr(subResourceName, "format.resourceName", "%resource-name%"),
+					resource.TestCheckResourceAttr(subResourceName, "protocol", "web"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoint", "https://test.me"),
+				),
+			},
+			{
+				Config: createSubscriptionTerraform(testSubscription_EventTypes_Update, subscriptionName, groupResourceId, groupTerraform),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, groupResourceName),
+					testCheckElastigroupAttributes(&group, groupName),
+
+					testCheckSubscriptionExists(&sub, subResourceName),
+					resource.TestCheckResourceAttr(subResourceName, "event_types.#", "2"),
+					resource.TestCheckResourceAttr(subResourceName, "subscription_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(subResourceName, "subscription_ids.AWS_EC2_INSTANCE_LAUNCH"),
+					resource.TestCheckResourceAttrSet(subResourceName, "subscription_ids.GROUP_ROLL_FINISHED"),
+					resource.TestCheckResourceAttr(subResourceName, "format.%", "1"),
+					resource.TestCheckResourceAttr(subResourceName, "format.event", "%event%"),
+					resource.TestCheckResourceAttr(subResourceName, "protocol", "web"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoint", "https://test.that"),
+				),
+			},
+		},
+	})
+}
+
+const testSubscription_EventTypes_Create = `
+resource "` + string(commons.SubscriptionResourceName) + `" "%v" {
+  provider = "aws"
+  resource_id="%v"
+  event_types=["AWS_EC2_INSTANCE_LAUNCH", "AWS_EC2_INSTANCE_TERMINATE"]
+
+  format = {
+		instanceId   = "%%instance-id%%"
+		resourceName = "%%resource-name%%"
+  }
+
+  protocol="web"
+  endpoint="https://test.me"
+  signing_secret="test-secret"
+}
+`
+
+const testSubscription_EventTypes_Update = `
+resource "` + string(commons.SubscriptionResourceName) + `" "%v" {
+  provider = "aws"
+  resource_id="%v"
+  event_types=["AWS_EC2_INSTANCE_LAUNCH", "GROUP_ROLL_FINISHED"]
+
+  format = {
+		event = "%%event%%"
+  }
+
+  protocol="web"
+  endpoint="https://test.that"
+  signing_secret="test-secret-updated"
+}
+`
+
+// endregion
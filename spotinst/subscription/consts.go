@@ -12,4 +12,8 @@ const (
 	Protocol   commons.FieldName = "protocol"
 	Endpoint   commons.FieldName = "endpoint"
 	Format     commons.FieldName = "format"
+
+	EventTypes      commons.FieldName = "event_types"
+	SigningSecret   commons.FieldName = "signing_secret"
+	SubscriptionIds commons.FieldName = "subscription_ids"
 )
@@ -0,0 +1,180 @@
+package subscription
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	ResourceTypeElastigroupAWS     = "elastigroup_aws"
+	ResourceTypeElastigroupAzure   = "elastigroup_azure"
+	ResourceTypeManagedInstanceAWS = "managed_instance_aws"
+	ResourceTypeMRScalerAWS        = "mrscaler_aws"
+	ResourceTypeOcean              = "ocean"
+)
+
+// EventTypesByResourceType holds the event types that can be subscribed to, per resource type.
+var EventTypesByResourceType = map[string][]string{
+	ResourceTypeElastigroupAWS: {
+		"AWS_EC2_INSTANCE_TERMINATE",
+		"AWS_EC2_INSTANCE_TERMINATED",
+		"AWS_EC2_INSTANCE_LAUNCH",
+		"AWS_EC2_INSTANCE_READY_SIGNAL_TIMEOUT",
+		"AWS_EC2_CANT_SPIN_OD",
+		"AWS_EC2_INSTANCE_UNHEALTHY_IN_ELB",
+		"GROUP_ROLL_FAILED",
+		"GROUP_ROLL_FINISHED",
+		"CANT_SCALE_UP_GROUP_MAX_CAPACITY",
+		"GROUP_UPDATED",
+		"GROUP_BEANSTALK_INIT_READY",
+	},
+	ResourceTypeElastigroupAzure: {
+		"AZURE_VM_TERMINATED",
+		"AZURE_VM_TERMINATE",
+		"GROUP_ROLL_FAILED",
+		"GROUP_ROLL_FINISHED",
+		"CANT_SCALE_UP_GROUP_MAX_CAPACITY",
+		"GROUP_UPDATED",
+	},
+	ResourceTypeManagedInstanceAWS: {
+		"AWS_EC2_MANAGED_INSTANCE_PAUSING",
+		"AWS_EC2_MANAGED_INSTANCE_RESUMING",
+		"AWS_EC2_MANAGED_INSTANCE_RECYCLING",
+		"AWS_EC2_MANAGED_INSTANCE_DELETING",
+	},
+	ResourceTypeMRScalerAWS: {
+		"AWS_EMR_PROVISION_TIMEOUT",
+	},
+	ResourceTypeOcean: {
+		"CLUSTER_ROLL_FINISHED",
+		"GROUP_ROLL_FAILED",
+	},
+}
+
+// FormatPlaceholders holds the placeholders that can be used in the `format` payload template.
+var FormatPlaceholders = []string{
+	"event",
+	"instance-id",
+	"resource-id",
+	"resource-name",
+	"subnet-id",
+	"availability-zone",
+	"reason",
+	"private-ip",
+	"launchspec-id",
+}
+
+// SigningProtocols holds the protocols that support signing the payload with a secret.
+var SigningProtocols = []string{"http", "https", "web"}
+
+var placeholderRegex = regexp.MustCompile(`%([^%\s]*)%`)
+
+// ResourceTypes returns the sorted list of supported resource types.
+func ResourceTypes() []string {
+	out := make([]string, 0, len(EventTypesByResourceType))
+	for resourceType := range EventTypesByResourceType {
+		out = append(out, resourceType)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// AllEventTypes returns the sorted, de-duplicated list of every supported event type.
+func AllEventTypes() []string {
+	seen := make(map[string]bool)
+	out := make([]string, 0)
+	for _, eventTypes := range EventTypesByResourceType {
+		for _, eventType := range eventTypes {
+			if !seen[eventType] {
+				seen[eventType] = true
+				out = append(out, eventType)
+			}
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func isKnownEventType(eventType string) bool {
+	for _, known := range AllEventTypes() {
+		if strings.EqualFold(known, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateEventType validates that the value is a known event type.
+func ValidateEventType(v interface{}, k string) (warns []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !isKnownEventType(value) {
+		errs = append(errs, fmt.Errorf("%q: unknown event type %q, expected one of: %s",
+			k, value, strings.Join(AllEventTypes(), ", ")))
+	}
+	return
+}
+
+// WarnUnknownEventType warns when the value is not a known event type. It is
+// used on `event_type`, which accepted any value before the list was known.
+func WarnUnknownEventType(v interface{}, k string) (warns []string, errs []error) {
+	value, ok := v.(string)
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !isKnownEventType(value) {
+		warns = append(warns, fmt.Sprintf("%q: unknown event type %q, expected one of: %s",
+			k, value, strings.Join(AllEventTypes(), ", ")))
+	}
+	return
+}
+
+// ValidateFormat validates that every placeholder used in the format map is supported.
+func ValidateFormat(v interface{}, k string) (warns []string, errs []error) {
+	format, ok := v.(map[string]interface{})
+	if !ok {
+		errs = append(errs, fmt.Errorf("expected type of %q to be map", k))
+		return
+	}
+
+	for key, raw := range format {
+		value, ok := raw.(string)
+		if !ok {
+			continue
+		}
+		for _, match := range placeholderRegex.FindAllStringSubmatch(value, -1) {
+			if !isKnownPlaceholder(match[1]) {
+				errs = append(errs, fmt.Errorf("%s.%s: unknown placeholder %q, expected one of: %%%s%%",
+					k, key, match[0], strings.Join(FormatPlaceholders, "%, %")))
+			}
+		}
+	}
+	return
+}
+
+func isKnownPlaceholder(placeholder string) bool {
+	for _, known := range FormatPlaceholders {
+		if known == placeholder {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsSigning reports whether notifications sent over the given protocol can be signed.
+func SupportsSigning(protocol string) bool {
+	for _, p := range SigningProtocols {
+		if p == protocol {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package subscription
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -47,8 +48,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.Subscription,
 		EventType,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{string(EventTypes)},
+			ValidateFunc:  WarnUnknownEventType,
 			StateFunc: func(v interface{}) string {
 				value := v.(string)
 				return strings.ToUpper(value)
@@ -56,6 +60,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
+			if _, ok := resourceData.GetOk(string(EventTypes)); ok {
+				// Each event type is managed by its own subscription, see `subscription_ids`.
+				return nil
+			}
 			if err := resourceData.Set(string(EventType), sub.EventType); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(EventType), err)
 			}
@@ -63,14 +71,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
-			if v, ok := resourceData.Get(string(EventType)).(string); ok {
+			if v, ok := resourceData.Get(string(EventType)).(string); ok && v != "" {
 				sub.SetEventType(spotinst.String(strings.ToUpper(v)))
 			}
 			return nil
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
-			if v, ok := resourceData.Get(string(EventType)).(string); ok {
+			if v, ok := resourceData.Get(string(EventType)).(string); ok && v != "" {
 				sub.SetEventType(spotinst.String(strings.ToUpper(v)))
 			}
 			return nil
@@ -144,8 +152,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.Subscription,
 		Format,
 		&schema.Schema{
-			Type:     schema.TypeMap,
-			Optional: true,
+			Type:         schema.TypeMap,
+			Optional:     true,
+			Elem:         &schema.Schema{Type: schema.TypeString},
+			ValidateFunc: ValidateFormat,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
@@ -170,4 +180,78 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[EventTypes] = commons.NewGenericField(
+		commons.Subscription,
+		EventTypes,
+		&schema.Schema{
+			Type:          schema.TypeSet,
+			Optional:      true,
+			MinItems:      1,
+			ConflictsWith: []string{string(EventType)},
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: ValidateEventType,
+				StateFunc: func(v interface{}) string {
+					value := v.(string)
+					return strings.ToUpper(value)
+				},
+			},
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[SigningSecret] = commons.NewGenericField(
+		commons.Subscription,
+		SigningSecret,
+		&schema.Schema{
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			sub := resourceObject.(*subscription.Subscription)
+			if v, ok := resourceData.Get(string(SigningSecret)).(string); ok && v != "" {
+				sub.SetSecret(spotinst.String(v))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			sub := resourceObject.(*subscription.Subscription)
+			if v, ok := resourceData.Get(string(SigningSecret)).(string); ok && v != "" {
+				sub.SetSecret(spotinst.String(v))
+			} else {
+				sub.SetSecret(nil)
+			}
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[SubscriptionIds] = commons.NewGenericField(
+		commons.Subscription,
+		SubscriptionIds,
+		&schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil, nil, nil, nil,
+	)
+}
+
+// ExpandEventTypes returns the sorted, upper-cased event types configured on the resource.
+// When `event_types` is not set, the single `event_type` is returned.
+func ExpandEventTypes(resourceData *schema.ResourceData) []string {
+	out := make([]string, 0)
+	if v, ok := resourceData.GetOk(string(EventTypes)); ok {
+		for _, eventType := range v.(*schema.Set).List() {
+			out = append(out, strings.ToUpper(eventType.(string)))
+		}
+	} else if v, ok := resourceData.GetOk(string(EventType)); ok {
+		out = append(out, strings.ToUpper(v.(string)))
+	}
+	sort.Strings(out)
+	return out
 }